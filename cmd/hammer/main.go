@@ -2,16 +2,15 @@ package main
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/allisson/go-env"
-	"github.com/allisson/go-pglock"
 	"github.com/allisson/hammer"
 	pb "github.com/allisson/hammer/api/v1"
 	hammerGrpc "github.com/allisson/hammer/grpc"
@@ -26,6 +25,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/joho/godotenv/autoload"
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/zap"
@@ -35,45 +35,54 @@ import (
 var (
 	logger       *zap.Logger
 	sqlDB        *sqlx.DB
-	sqlConn      *sql.Conn
 	grpcEndpoint string
 	httpEndpoint string
 )
 
 type appContext struct {
 	topicService           hammer.TopicService
+	topicSchemaService     hammer.TopicSchemaService
 	subscriptionService    hammer.SubscriptionService
 	messageService         hammer.MessageService
 	deliveryService        hammer.DeliveryService
 	deliveryAttemptService hammer.DeliveryAttemptService
+	circuitBreakerService  hammer.CircuitBreakerService
 	migrationService       hammer.MigrationService
+	metricsCollectors      []prometheus.Collector
 }
 
 func newAppContext() appContext {
 	// Create repositories
 	topicRepo := repository.NewTopic(sqlDB)
+	topicSchemaRepo := repository.NewTopicSchema(sqlDB)
 	subscriptionRepo := repository.NewSubscription(sqlDB)
 	messageRepo := repository.NewMessage(sqlDB)
 	deliveryRepo := repository.NewDelivery(sqlDB)
 	deliveryAttemptRepo := repository.NewDeliveryAttempt(sqlDB)
+	circuitBreakerRepo := repository.NewCircuitBreaker(sqlDB)
 	txFactoryRepo := repository.NewTxFactory(sqlDB)
 	migrationRepo := repository.NewMigration(sqlDB, env.GetString("HAMMER_DATABASE_MIGRATION_DIR", "file:///db/migrations"))
 
 	// Create services
 	topicService := service.NewTopic(&topicRepo, &txFactoryRepo)
+	topicSchemaService := service.NewTopicSchema(&topicRepo, &topicSchemaRepo, &txFactoryRepo)
 	subscriptionService := service.NewSubscription(&topicRepo, &subscriptionRepo, &txFactoryRepo)
 	messageService := service.NewMessage(&topicRepo, &messageRepo, &subscriptionRepo, &deliveryRepo, &txFactoryRepo)
-	deliveryService := service.NewDelivery(&deliveryRepo, &deliveryAttemptRepo, &txFactoryRepo)
+	deliveryService := service.NewDelivery(&topicRepo, &messageRepo, &subscriptionRepo, &deliveryRepo, &deliveryAttemptRepo, &circuitBreakerRepo, &txFactoryRepo)
 	deliveryAttemptService := service.NewDeliveryAttempt(&deliveryAttemptRepo)
+	circuitBreakerService := service.NewCircuitBreaker(&circuitBreakerRepo)
 	migrationService := service.NewMigration(&migrationRepo)
 
 	return appContext{
 		topicService:           &topicService,
+		topicSchemaService:     &topicSchemaService,
 		subscriptionService:    &subscriptionService,
 		messageService:         &messageService,
 		deliveryService:        &deliveryService,
 		deliveryAttemptService: &deliveryAttemptService,
+		circuitBreakerService:  &circuitBreakerService,
 		migrationService:       &migrationService,
+		metricsCollectors:      []prometheus.Collector{&circuitBreakerService},
 	}
 }
 
@@ -125,12 +134,6 @@ func healthCheckServer() {
 			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 			return
 		}
-		if sqlConn != nil {
-			if err := sqlConn.PingContext(context.Background()); err != nil {
-				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-				return
-			}
-		}
 		w.WriteHeader(http.StatusNoContent)
 	}
 	mux.HandleFunc("/liveness", handler)
@@ -183,10 +186,12 @@ func main() {
 			Action: func(c *cli.Context) error {
 				// Create grpc handlers
 				topicHandler := hammerGrpc.NewTopicHandler(ac.topicService)
+				topicSchemaHandler := hammerGrpc.NewTopicSchemaHandler(ac.topicSchemaService)
 				subscriptionHandler := hammerGrpc.NewSubscriptionHandler(ac.subscriptionService)
 				messageHandler := hammerGrpc.NewMessageHandler(ac.messageService)
 				deliveryHandler := hammerGrpc.NewDeliveryHandler(ac.deliveryService)
 				deliveryAttemptHandler := hammerGrpc.NewDeliveryAttemptHandler(ac.deliveryAttemptService)
+				circuitBreakerHandler := hammerGrpc.NewCircuitBreakerHandler(ac.circuitBreakerService)
 
 				// Start tcp server
 				listener, err := net.Listen("tcp", grpcEndpoint)
@@ -215,7 +220,7 @@ func main() {
 						grpc_recovery.UnaryServerInterceptor(),
 					)),
 				)
-				server := hammerGrpc.NewServer(topicHandler, subscriptionHandler, messageHandler, deliveryHandler, deliveryAttemptHandler)
+				server := hammerGrpc.NewServer(topicHandler, topicSchemaHandler, subscriptionHandler, messageHandler, deliveryHandler, deliveryAttemptHandler, circuitBreakerHandler)
 
 				// Register grpc services
 				pb.RegisterHammerServer(grpcServer, &server)
@@ -226,6 +231,9 @@ func main() {
 				// Register grpc_prometheus default metrics
 				grpc_prometheus.Register(grpcServer)
 
+				// Register circuit breaker metrics
+				prometheus.MustRegister(ac.metricsCollectors...)
+
 				// Load metrics server
 				go metricsServer()
 
@@ -276,16 +284,15 @@ func main() {
 			Aliases: []string{"w"},
 			Usage:   "Starts the worker",
 			Action: func(c *cli.Context) error {
-				// Create lock
-				conn, err := sqlDB.DB.Conn(context.Background())
+				// Listen for new deliveries
+				notificationRepo, err := repository.NewNotification(env.GetString("HAMMER_DATABASE_URL", ""))
 				if err != nil {
 					return err
 				}
-				sqlConn = conn
-				lock := pglock.NewLock(sqlConn)
+				defer notificationRepo.Close()
 
 				// Create worker service
-				workerService := service.NewWorker(&lock, ac.deliveryService)
+				workerService := service.NewWorker(ac.deliveryService, &notificationRepo, hammer.WorkerConcurrency)
 
 				// Start health check
 				go healthCheckServer()
@@ -301,9 +308,11 @@ func main() {
 
 					<-sigint
 
-					// We received an interrupt signal, shut down.
+					// We received an interrupt signal, shut down after the in-flight deliveries are finished.
 					logger.Info("worker-shutdown-started")
-					if err := workerService.Stop(); err != nil {
+					ctx, cancel := context.WithTimeout(context.Background(), time.Duration(hammer.WorkerDrainTimeout)*time.Second)
+					defer cancel()
+					if err := workerService.Stop(ctx); err != nil {
 						logger.Error("worker-service-stop", zap.Error(err))
 					}
 					close(idleConnsClosed)
@@ -311,7 +320,7 @@ func main() {
 				}()
 
 				logger.Info("worker-started")
-				if err := workerService.Run(); err != nil {
+				if err := workerService.Run(context.Background()); err != nil {
 					logger.Error("worker-service-run", zap.Error(err))
 				}
 
@@ -0,0 +1,2 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS signing_mode;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS signing_mode;
@@ -0,0 +1,4 @@
+-- existing subscriptions keep sending the secret token on payload
+
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS signing_mode VARCHAR NOT NULL DEFAULT 'legacy';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS signing_mode VARCHAR NOT NULL DEFAULT 'legacy';
@@ -0,0 +1,6 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS retry_jitter;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS retry_max_delay;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS retry_policy;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS retry_jitter;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS retry_max_delay;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS retry_policy;
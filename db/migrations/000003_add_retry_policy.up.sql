@@ -0,0 +1,6 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS retry_policy VARCHAR NOT NULL DEFAULT 'fixed';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS retry_max_delay INT NOT NULL DEFAULT 0;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS retry_jitter BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS retry_policy VARCHAR NOT NULL DEFAULT 'fixed';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS retry_max_delay INT NOT NULL DEFAULT 0;
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS retry_jitter BOOLEAN NOT NULL DEFAULT FALSE;
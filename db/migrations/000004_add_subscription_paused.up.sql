@@ -0,0 +1 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS paused BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS filter_expression VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS messages_topic_id_idempotency_key_idx;
+ALTER TABLE messages DROP COLUMN IF EXISTS idempotency_key;
@@ -0,0 +1,2 @@
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX IF NOT EXISTS messages_topic_id_idempotency_key_idx ON messages (topic_id, idempotency_key) WHERE idempotency_key <> '';
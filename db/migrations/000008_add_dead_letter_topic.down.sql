@@ -0,0 +1,2 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS dead_letter_topic_id;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS dead_letter_topic_id;
@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS dead_letter_topic_id VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS dead_letter_topic_id VARCHAR NOT NULL DEFAULT '';
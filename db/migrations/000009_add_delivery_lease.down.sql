@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS deliveries_lease_expires_at_idx;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS lease_owner;
@@ -0,0 +1,3 @@
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS lease_owner VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMPTZ NOT NULL DEFAULT '1970-01-01 00:00:00+00';
+CREATE INDEX IF NOT EXISTS deliveries_lease_expires_at_idx ON deliveries (lease_expires_at);
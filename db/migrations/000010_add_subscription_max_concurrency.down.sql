@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS deliveries_subscription_id_lease_expires_at_idx;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS max_concurrency;
@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS max_concurrency INT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS deliveries_subscription_id_lease_expires_at_idx ON deliveries (subscription_id, lease_expires_at) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS subscription_rate_limits;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS rate_limit_burst;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS rate_limit;
@@ -0,0 +1,13 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS rate_limit INT NOT NULL DEFAULT 0;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS rate_limit_burst INT NOT NULL DEFAULT 0;
+
+-- subscription_rate_limits table, token bucket shared by all workers
+
+CREATE TABLE IF NOT EXISTS subscription_rate_limits(
+    subscription_id VARCHAR PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT 'epoch',
+    FOREIGN KEY (subscription_id) REFERENCES subscriptions (id) ON DELETE CASCADE
+);
+
+INSERT INTO subscription_rate_limits (subscription_id) SELECT id FROM subscriptions ON CONFLICT DO NOTHING;
@@ -0,0 +1,14 @@
+-- circuit_breakers table, the state of each subscription endpoint shared by all workers
+
+CREATE TABLE IF NOT EXISTS circuit_breakers(
+    subscription_id VARCHAR PRIMARY KEY,
+    state VARCHAR NOT NULL DEFAULT 'closed',
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    opened_at TIMESTAMPTZ NOT NULL DEFAULT 'epoch',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (subscription_id) REFERENCES subscriptions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS circuit_breakers_state_idx ON circuit_breakers (state) WHERE state <> 'closed';
+
+INSERT INTO circuit_breakers (subscription_id) SELECT id FROM subscriptions ON CONFLICT DO NOTHING;
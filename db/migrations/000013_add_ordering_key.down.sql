@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS deliveries_subscription_id_ordering_key_idx;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS ordering_failure_policy;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS ordering_enabled;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS ordering_key;
+ALTER TABLE messages DROP COLUMN IF EXISTS ordering_key;
@@ -0,0 +1,5 @@
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS ordering_key VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS ordering_key VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS ordering_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS ordering_failure_policy VARCHAR NOT NULL DEFAULT 'skip';
+CREATE INDEX IF NOT EXISTS deliveries_subscription_id_ordering_key_idx ON deliveries (subscription_id, ordering_key, id) WHERE ordering_key <> '';
@@ -0,0 +1,8 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS pause_on_gone;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS permanent_failure_status_codes;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS redirect_policy;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS success_status_codes;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS pause_on_gone;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS permanent_failure_status_codes;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS redirect_policy;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS success_status_codes;
@@ -0,0 +1,12 @@
+-- Existing subscriptions keep the status codes that were handled as success before this column
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS success_status_codes VARCHAR NOT NULL DEFAULT '200,201,202,204';
+ALTER TABLE subscriptions ALTER COLUMN success_status_codes SET DEFAULT '200-299';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS redirect_policy VARCHAR NOT NULL DEFAULT 'follow';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS permanent_failure_status_codes VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS pause_on_gone BOOLEAN NOT NULL DEFAULT FALSE;
+-- Existing deliveries keep the status codes that were handled as success before this column
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS success_status_codes VARCHAR NOT NULL DEFAULT '200,201,202,204';
+ALTER TABLE deliveries ALTER COLUMN success_status_codes SET DEFAULT '200-299';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS redirect_policy VARCHAR NOT NULL DEFAULT 'follow';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS permanent_failure_status_codes VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS pause_on_gone BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS http_method;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS headers;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS http_method;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS headers;
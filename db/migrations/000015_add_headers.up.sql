@@ -0,0 +1,4 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS headers JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS http_method VARCHAR NOT NULL DEFAULT 'POST';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS headers JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS http_method VARCHAR NOT NULL DEFAULT 'POST';
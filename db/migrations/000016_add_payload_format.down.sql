@@ -0,0 +1,2 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS payload_format;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS payload_format;
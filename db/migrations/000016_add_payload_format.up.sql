@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS payload_format VARCHAR NOT NULL DEFAULT 'structured';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS payload_format VARCHAR NOT NULL DEFAULT 'structured';
@@ -0,0 +1,4 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS transform_content_type;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS transform_template;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS transform_content_type;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS transform_template;
@@ -0,0 +1,4 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS transform_template TEXT NOT NULL DEFAULT '';
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS transform_content_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS transform_template TEXT NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS transform_content_type VARCHAR NOT NULL DEFAULT '';
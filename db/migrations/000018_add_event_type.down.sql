@@ -0,0 +1,6 @@
+ALTER TABLE deliveries DROP COLUMN IF EXISTS event_source;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS event_type;
+ALTER TABLE messages DROP COLUMN IF EXISTS event_source;
+ALTER TABLE messages DROP COLUMN IF EXISTS event_type;
+ALTER TABLE topics DROP COLUMN IF EXISTS event_source;
+ALTER TABLE topics DROP COLUMN IF EXISTS event_type;
@@ -0,0 +1,6 @@
+ALTER TABLE topics ADD COLUMN IF NOT EXISTS event_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE topics ADD COLUMN IF NOT EXISTS event_source VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS event_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS event_source VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS event_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS event_source VARCHAR NOT NULL DEFAULT '';
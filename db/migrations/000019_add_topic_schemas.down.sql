@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS topic_schemas;
+ALTER TABLE topics DROP COLUMN IF EXISTS schema_version;
+ALTER TABLE topics DROP COLUMN IF EXISTS schema;
@@ -0,0 +1,13 @@
+-- topic_schemas table, the versions of the JSON Schema of the topic messages
+
+ALTER TABLE topics ADD COLUMN IF NOT EXISTS schema TEXT NOT NULL DEFAULT '';
+ALTER TABLE topics ADD COLUMN IF NOT EXISTS schema_version INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS topic_schemas(
+    topic_id VARCHAR NOT NULL,
+    version INT NOT NULL,
+    schema TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (topic_id, version),
+    FOREIGN KEY (topic_id) REFERENCES topics (id) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS throttled_attempts INT NOT NULL DEFAULT 0;
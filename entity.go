@@ -1,11 +1,22 @@
 package hammer
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/allisson/go-env"
+	"github.com/allisson/hammer/filter"
+	"github.com/allisson/hammer/schema"
+	"github.com/allisson/hammer/transform"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
@@ -17,10 +28,83 @@ const (
 	DeliveryStatusFailed = "failed"
 	// DeliveryStatusCompleted represents the delivery completed status
 	DeliveryStatusCompleted = "completed"
+	// DeliveryStatusCancelled represents the delivery cancelled status
+	DeliveryStatusCancelled = "cancelled"
+	// SigningModeHMAC represents the signing mode that sends a HMAC-SHA256 signature on request headers
+	SigningModeHMAC = "hmac"
+	// SigningModeLegacy represents the signing mode that sends the secret token on payload
+	SigningModeLegacy = "legacy"
+	// RetryPolicyFixed represents the retry policy that waits the same delay between attempts
+	RetryPolicyFixed = "fixed"
+	// RetryPolicyLinear represents the retry policy that increases the delay linearly between attempts
+	RetryPolicyLinear = "linear"
+	// RetryPolicyExponential represents the retry policy that doubles the delay between attempts
+	RetryPolicyExponential = "exponential"
+	// CircuitBreakerStateClosed represents the circuit breaker state that dispatches the deliveries
+	CircuitBreakerStateClosed = "closed"
+	// CircuitBreakerStateOpen represents the circuit breaker state that defers the deliveries
+	CircuitBreakerStateOpen = "open"
+	// CircuitBreakerStateHalfOpen represents the circuit breaker state that dispatches a single probe delivery
+	CircuitBreakerStateHalfOpen = "half_open"
+	// OrderingFailurePolicyBlock represents the ordering failure policy that keeps the next deliveries with the same ordering key pending while a delivery is failed
+	OrderingFailurePolicyBlock = "block"
+	// OrderingFailurePolicySkip represents the ordering failure policy that dispatches the next deliveries with the same ordering key after a delivery fails
+	OrderingFailurePolicySkip = "skip"
+	// DefaultEventType represents the CloudEvents type of a message when the topic and the message don't inform it
+	DefaultEventType = "hammer.message.created"
+	// RedirectPolicyFollow represents the redirect policy that follows the redirects of the delivery request
+	RedirectPolicyFollow = "follow"
+	// RedirectPolicyNone represents the redirect policy that uses the redirect response as the delivery response
+	RedirectPolicyNone = "none"
+	// PayloadFormatStructured represents the payload format that sends the CloudEvents json envelope with the data on data_base64
+	PayloadFormatStructured = "structured"
+	// PayloadFormatStructuredData represents the payload format that sends the CloudEvents json envelope with the json data inline on data
+	PayloadFormatStructuredData = "structured_data"
+	// PayloadFormatBinary represents the payload format that sends the data as request body and the CloudEvents attributes on ce-* headers
+	PayloadFormatBinary = "binary"
+	// PayloadFormatRaw represents the payload format that sends the message data as request body without the CloudEvents attributes
+	PayloadFormatRaw = "raw"
+	// DefaultSuccessStatusCodes represents the status codes of a successful delivery when the subscription does not inform them
+	DefaultSuccessStatusCodes = "200-299"
+	// RedactedHeaderValue replaces the values of the headers listed on RedactedHeaders
+	RedactedHeaderValue = "[REDACTED]"
 )
 
 var (
 	idRegex = regexp.MustCompile(`^[\w.+-]+$`)
+	// attributeNameRegex follows the CloudEvents naming convention for extension attributes
+	attributeNameRegex = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+	// reservedAttributeNames can't be used as message attributes because they are already used on CloudEventPayload
+	reservedAttributeNames = map[string]bool{
+		"specversion":     true,
+		"type":            true,
+		"source":          true,
+		"id":              true,
+		"time":            true,
+		"subject":         true,
+		"dataschema":      true,
+		"datacontenttype": true,
+		"data":            true,
+		"secrettoken":     true,
+		"messageid":       true,
+		"subscriptionid":  true,
+		"topicid":         true,
+	}
+	// headerNameRegex follows the token definition of RFC 7230 for header field names
+	headerNameRegex = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+	// reservedHeaderNames can't be used as subscription headers because they are set by the dispatcher
+	reservedHeaderNames = map[string]bool{
+		"Host":                 true,
+		"Content-Type":         true,
+		"Content-Length":       true,
+		"Transfer-Encoding":    true,
+		"Connection":           true,
+		"X-Hammer-Timestamp":   true,
+		"X-Hammer-Signature":   true,
+		"X-Hammer-Delivery-Id": true,
+		"X-Hammer-Message-Id":  true,
+		"X-Hammer-Attempt":     true,
+	}
 	// ErrTopicAlreadyExists is used when the topic already exists on repository.
 	ErrTopicAlreadyExists = errors.New("topic_already_exists")
 	// ErrTopicDoesNotExists is used when the topic does not exists on repository.
@@ -29,30 +113,171 @@ var (
 	ErrSubscriptionAlreadyExists = errors.New("subscription_already_exists")
 	// ErrSubscriptionDoesNotExists is used when the subscription does not exists on repository.
 	ErrSubscriptionDoesNotExists = errors.New("subscription_does_not_exists")
+	// ErrDeadLetterTopicDoesNotExists is used when the subscription dead-letter topic does not exists on repository.
+	ErrDeadLetterTopicDoesNotExists = errors.New("dead_letter_topic_does_not_exists")
+	// ErrDeadLetterTopicCycle is used when the failed deliveries would be published again on the subscription topic through the dead-letter topics.
+	ErrDeadLetterTopicCycle = errors.New("dead_letter_topic_cycle")
 	// ErrMessageDoesNotExists is used when the message does not exists on repository.
 	ErrMessageDoesNotExists = errors.New("message_does_not_exists")
+	// ErrMessageIdempotencyKeyConflict is used when another message with the same idempotency key was created concurrently.
+	ErrMessageIdempotencyKeyConflict = errors.New("message_idempotency_key_conflict")
 	// ErrDeliveryDoesNotExists is used when the delivery does not exists on repository.
 	ErrDeliveryDoesNotExists = errors.New("delivery_does_not_exists")
+	// ErrDeliveryIsNotPending is used when the delivery can't be cancelled because it's not pending.
+	ErrDeliveryIsNotPending = errors.New("delivery_is_not_pending")
+	// ErrDeliveryCannotBeRedelivered is used when the delivery can't be redelivered because it's not completed or failed.
+	ErrDeliveryCannotBeRedelivered = errors.New("delivery_cannot_be_redelivered")
+	// ErrDeliveryDispatchInterrupted is used when the delivery request is interrupted before a response, the delivery is left pending for retry.
+	ErrDeliveryDispatchInterrupted = errors.New("delivery_dispatch_interrupted")
 	// ErrDeliveryAttemptDoesNotExists is used when the delivery attempt does not exists on repository.
 	ErrDeliveryAttemptDoesNotExists = errors.New("delivery_attempt_does_not_exists")
+	// ErrCircuitBreakerDoesNotExists is used when the circuit breaker does not exists on repository.
+	ErrCircuitBreakerDoesNotExists = errors.New("circuit_breaker_does_not_exists")
+	// ErrTopicSchemaDoesNotExists is used when the topic schema does not exists on repository.
+	ErrTopicSchemaDoesNotExists = errors.New("topic_schema_does_not_exists")
 	// DefaultPaginationLimit represents a default pagination limit on resource list
 	DefaultPaginationLimit = env.GetInt("HAMMER_DEFAULT_PAGINATION_LIMIT", 25)
 	// MaxPaginationLimit represents the max value for pagination limit on resource list
 	MaxPaginationLimit = env.GetInt("HAMMER_MAX_PAGINATION_LIMIT", 50)
 	// DefaultSecretTokenLength represents a default length for a random string to secret token if it is not informed
 	DefaultSecretTokenLength = env.GetInt("HAMMER_DEFAULT_SECRET_TOKEN_LENGTH", 40)
+	// IdempotencyKeyRetention represents the time in seconds that a message idempotency key is kept
+	IdempotencyKeyRetention = env.GetInt("HAMMER_IDEMPOTENCY_KEY_RETENTION", 86400)
+	// MaxBatchSize represents the max number of messages on a batch publish
+	MaxBatchSize = env.GetInt("HAMMER_MAX_BATCH_SIZE", 1000)
 	// WorkerDatabaseDelay represents a delay for database access by workers
 	WorkerDatabaseDelay = env.GetInt("HAMMER_WORKER_DATABASE_DELAY", 5)
 	// WorkerDefaultFetchLimit represents the default value for fetch limit
 	WorkerDefaultFetchLimit = env.GetInt("HAMMER_WORKER_DEFAULT_FETCH_LIMIT", 100)
+	// WorkerConcurrency represents the max number of deliveries dispatched at the same time by a worker
+	WorkerConcurrency = env.GetInt("HAMMER_WORKER_CONCURRENCY", 100)
+	// WorkerDrainTimeout represents the time in seconds that a worker waits for the in-flight deliveries on shutdown
+	WorkerDrainTimeout = env.GetInt("HAMMER_WORKER_DRAIN_TIMEOUT", 30)
+	// WorkerLeaseDuration represents the time in seconds added to the delivery attempt timeout while a delivery is leased by a worker
+	WorkerLeaseDuration = env.GetInt("HAMMER_WORKER_LEASE_DURATION", 60)
+	// CircuitBreakerThreshold represents the number of consecutive failed attempts that opens the subscription circuit breaker, zero disables it
+	CircuitBreakerThreshold = env.GetInt("HAMMER_CIRCUIT_BREAKER_THRESHOLD", 5)
+	// CircuitBreakerOpenTimeout represents the time in seconds that a circuit breaker stays open before the half-open probe
+	CircuitBreakerOpenTimeout = env.GetInt("HAMMER_CIRCUIT_BREAKER_OPEN_TIMEOUT", 60)
+	// RetryAfterMaxDelay represents the max time in seconds accepted from a Retry-After response header
+	RetryAfterMaxDelay = env.GetInt("HAMMER_RETRY_AFTER_MAX_DELAY", 3600)
+	// RetryAfterCountAttempts represents if the throttled responses with a Retry-After header count against the max delivery attempts
+	RetryAfterCountAttempts = env.GetBool("HAMMER_RETRY_AFTER_COUNT_ATTEMPTS", true)
+	// RetryAfterMaxThrottledAttempts represents the max number of throttled responses of a delivery that are not counted against the max delivery attempts
+	RetryAfterMaxThrottledAttempts = env.GetInt("HAMMER_RETRY_AFTER_MAX_THROTTLED_ATTEMPTS", 10)
+	// RedactedHeaders represents the headers that have the value redacted on delivery attempts and on subscription and delivery responses
+	RedactedHeaders = env.GetStringSlice("HAMMER_REDACTED_HEADERS", ",", []string{"Authorization", "Proxy-Authorization", "Cookie", "X-Api-Key"})
 )
 
+// IsRedactedHeader returns if the header value must be redacted
+func IsRedactedHeader(name string) bool {
+	for _, redactedName := range RedactedHeaders {
+		if strings.EqualFold(name, redactedName) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateFilterExpression(value interface{}) error {
+	s, _ := value.(string)
+	if err := filter.Validate(s); err != nil {
+		return validation.NewError("validation_invalid_filter_expression", err.Error())
+	}
+	return nil
+}
+
+func validateTransformTemplate(value interface{}) error {
+	s, _ := value.(string)
+	if err := transform.Validate(s); err != nil {
+		return validation.NewError("validation_invalid_transform_template", err.Error())
+	}
+	return nil
+}
+
+func validateSchema(value interface{}) error {
+	s, _ := value.(string)
+	if err := schema.Validate(s); err != nil {
+		return validation.NewError("validation_invalid_schema", err.Error())
+	}
+	return nil
+}
+
+func validateStatusCodes(value interface{}) error {
+	s, _ := value.(string)
+	if _, err := ParseStatusCodeRanges(s); err != nil {
+		return validation.NewError("validation_invalid_status_codes", err.Error())
+	}
+	return nil
+}
+
+func validateHeaders(value interface{}) error {
+	headers, _ := value.(StringMap)
+	for name, value := range headers {
+		if !headerNameRegex.MatchString(name) {
+			return validation.NewError("validation_invalid_header_name", fmt.Sprintf("header name %q is invalid", name))
+		}
+		if reservedHeaderNames[http.CanonicalHeaderKey(name)] || strings.HasPrefix(strings.ToLower(name), "ce-") {
+			return validation.NewError("validation_reserved_header_name", fmt.Sprintf("header name %q is reserved", name))
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return validation.NewError("validation_invalid_header_value", fmt.Sprintf("header %q value must not contain line breaks", name))
+		}
+	}
+	return nil
+}
+
+func validateAttributes(value interface{}) error {
+	attributes, _ := value.(StringMap)
+	for name := range attributes {
+		if !attributeNameRegex.MatchString(name) {
+			return validation.NewError("validation_invalid_attribute_name", fmt.Sprintf("attribute name %q must contain only lowercase letters and digits (max 20)", name))
+		}
+		if reservedAttributeNames[name] {
+			return validation.NewError("validation_reserved_attribute_name", fmt.Sprintf("attribute name %q is reserved", name))
+		}
+	}
+	return nil
+}
+
+// StringMap is a map[string]string stored as a json object on database
+type StringMap map[string]string
+
+// Value implements the driver.Valuer interface
+func (m StringMap) Value() (driver.Value, error) {
+	if m == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m)
+}
+
+// Scan implements the sql.Scanner interface
+func (m *StringMap) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*m = StringMap{}
+		return nil
+	default:
+		return fmt.Errorf("incompatible type for StringMap: %T", src)
+	}
+	return json.Unmarshal(data, m)
+}
+
 // Topic data
 type Topic struct {
-	ID        string    `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID            string    `json:"id" db:"id"`
+	Name          string    `json:"name" db:"name"`
+	EventType     string    `json:"event_type" db:"event_type"`
+	EventSource   string    `json:"event_source" db:"event_source"`
+	Schema        string    `json:"schema" db:"schema"`
+	SchemaVersion int       `json:"schema_version" db:"schema_version"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // Validate topic
@@ -60,21 +285,60 @@ func (t Topic) Validate() error {
 	return validation.ValidateStruct(&t,
 		validation.Field(&t.ID, validation.Required, validation.Match(idRegex)),
 		validation.Field(&t.Name, validation.Required),
+		validation.Field(&t.EventType, validation.Length(0, 255)),
+		validation.Field(&t.EventSource, validation.Length(0, 255)),
+	)
+}
+
+// TopicSchema data, a version of the JSON Schema of the topic messages
+type TopicSchema struct {
+	TopicID   string    `json:"topic_id" db:"topic_id"`
+	Version   int       `json:"version" db:"version"`
+	Schema    string    `json:"schema" db:"schema"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// Validate topic schema
+func (t TopicSchema) Validate() error {
+	return validation.ValidateStruct(&t,
+		validation.Field(&t.TopicID, validation.Required, validation.Match(idRegex)),
+		validation.Field(&t.Schema, validation.By(validateSchema)),
 	)
 }
 
 // Subscription data
 type Subscription struct {
-	ID                     string    `json:"id" db:"id"`
-	TopicID                string    `json:"topic_id" db:"topic_id"`
-	Name                   string    `json:"name" db:"name"`
-	URL                    string    `json:"url" db:"url"`
-	SecretToken            string    `json:"secret_token" db:"secret_token"`
-	MaxDeliveryAttempts    int       `json:"max_delivery_attempts" db:"max_delivery_attempts"`
-	DeliveryAttemptDelay   int       `json:"delivery_attempt_delay" db:"delivery_attempt_delay"`
-	DeliveryAttemptTimeout int       `json:"delivery_attempt_timeout" db:"delivery_attempt_timeout"`
-	CreatedAt              time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt              time.Time `json:"updated_at" db:"updated_at"`
+	ID                          string    `json:"id" db:"id"`
+	TopicID                     string    `json:"topic_id" db:"topic_id"`
+	Name                        string    `json:"name" db:"name"`
+	URL                         string    `json:"url" db:"url"`
+	SecretToken                 string    `json:"secret_token" db:"secret_token"`
+	SigningMode                 string    `json:"signing_mode" db:"signing_mode"`
+	MaxDeliveryAttempts         int       `json:"max_delivery_attempts" db:"max_delivery_attempts"`
+	DeliveryAttemptDelay        int       `json:"delivery_attempt_delay" db:"delivery_attempt_delay"`
+	DeliveryAttemptTimeout      int       `json:"delivery_attempt_timeout" db:"delivery_attempt_timeout"`
+	RetryPolicy                 string    `json:"retry_policy" db:"retry_policy"`
+	RetryMaxDelay               int       `json:"retry_max_delay" db:"retry_max_delay"`
+	RetryJitter                 bool      `json:"retry_jitter" db:"retry_jitter"`
+	Paused                      bool      `json:"paused" db:"paused"`
+	FilterExpression            string    `json:"filter_expression" db:"filter_expression"`
+	DeadLetterTopicID           string    `json:"dead_letter_topic_id" db:"dead_letter_topic_id"`
+	MaxConcurrency              int       `json:"max_concurrency" db:"max_concurrency"`
+	RateLimit                   int       `json:"rate_limit" db:"rate_limit"`
+	RateLimitBurst              int       `json:"rate_limit_burst" db:"rate_limit_burst"`
+	OrderingEnabled             bool      `json:"ordering_enabled" db:"ordering_enabled"`
+	OrderingFailurePolicy       string    `json:"ordering_failure_policy" db:"ordering_failure_policy"`
+	SuccessStatusCodes          string    `json:"success_status_codes" db:"success_status_codes"`
+	RedirectPolicy              string    `json:"redirect_policy" db:"redirect_policy"`
+	PermanentFailureStatusCodes string    `json:"permanent_failure_status_codes" db:"permanent_failure_status_codes"`
+	PauseOnGone                 bool      `json:"pause_on_gone" db:"pause_on_gone"`
+	Headers                     StringMap `json:"headers" db:"headers"`
+	HTTPMethod                  string    `json:"http_method" db:"http_method"`
+	PayloadFormat               string    `json:"payload_format" db:"payload_format"`
+	TransformTemplate           string    `json:"transform_template" db:"transform_template"`
+	TransformContentType        string    `json:"transform_content_type" db:"transform_content_type"`
+	CreatedAt                   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt                   time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // Validate subscription
@@ -84,18 +348,81 @@ func (s Subscription) Validate() error {
 		validation.Field(&s.TopicID, validation.Required, validation.Match(idRegex)),
 		validation.Field(&s.Name, validation.Required),
 		validation.Field(&s.URL, validation.Required, is.URL),
+		validation.Field(&s.SigningMode, validation.In(SigningModeHMAC, SigningModeLegacy), validation.When(s.PayloadFormat == PayloadFormatRaw, validation.NotIn(SigningModeLegacy))),
 		validation.Field(&s.MaxDeliveryAttempts, validation.Required, validation.Min(1)),
 		validation.Field(&s.DeliveryAttemptDelay, validation.Required, validation.Min(1)),
 		validation.Field(&s.DeliveryAttemptTimeout, validation.Required, validation.Min(1)),
+		validation.Field(&s.RetryPolicy, validation.In(RetryPolicyFixed, RetryPolicyLinear, RetryPolicyExponential)),
+		validation.Field(&s.RetryMaxDelay, validation.Min(0), validation.When(s.RetryMaxDelay != 0, validation.Min(s.DeliveryAttemptDelay))),
+		validation.Field(&s.FilterExpression, validation.By(validateFilterExpression)),
+		validation.Field(&s.DeadLetterTopicID, validation.Match(idRegex), validation.NotIn(s.TopicID)),
+		validation.Field(&s.MaxConcurrency, validation.Min(0)),
+		validation.Field(&s.RateLimit, validation.Min(0)),
+		validation.Field(&s.RateLimitBurst, validation.Min(0)),
+		validation.Field(&s.OrderingFailurePolicy, validation.In(OrderingFailurePolicyBlock, OrderingFailurePolicySkip)),
+		validation.Field(&s.SuccessStatusCodes, validation.By(validateStatusCodes)),
+		validation.Field(&s.RedirectPolicy, validation.In(RedirectPolicyFollow, RedirectPolicyNone)),
+		validation.Field(&s.PermanentFailureStatusCodes, validation.By(validateStatusCodes)),
+		validation.Field(&s.Headers, validation.By(validateHeaders)),
+		validation.Field(&s.HTTPMethod, validation.In(http.MethodPost, http.MethodPut, http.MethodPatch)),
+		validation.Field(&s.PayloadFormat, validation.In(PayloadFormatStructured, PayloadFormatStructuredData, PayloadFormatBinary, PayloadFormatRaw)),
+		validation.Field(&s.TransformTemplate, validation.By(validateTransformTemplate)),
 	)
 }
 
+// StatusCodeRange represents an inclusive range of HTTP status codes
+type StatusCodeRange struct {
+	Min int
+	Max int
+}
+
+// Contains returns true when the status code is on the range
+func (r StatusCodeRange) Contains(statusCode int) bool {
+	return statusCode >= r.Min && statusCode <= r.Max
+}
+
+// ParseStatusCodeRanges parses a comma separated list of status codes and status code ranges, eg: "200-299,308"
+func ParseStatusCodeRanges(value string) ([]StatusCodeRange, error) {
+	ranges := []StatusCodeRange{}
+	if strings.TrimSpace(value) == "" {
+		return ranges, nil
+	}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		bounds := strings.SplitN(item, "-", 2)
+		r := StatusCodeRange{}
+		var err error
+		if r.Min, err = strconv.Atoi(strings.TrimSpace(bounds[0])); err != nil {
+			return nil, fmt.Errorf("invalid status code %q", item)
+		}
+		r.Max = r.Min
+		if len(bounds) == 2 {
+			if r.Max, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+				return nil, fmt.Errorf("invalid status code range %q", item)
+			}
+		}
+		if r.Min < 100 || r.Max > 599 || r.Min > r.Max {
+			return nil, fmt.Errorf("invalid status code range %q", item)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
 // Message data
 type Message struct {
-	ID          string    `json:"id" db:"id"`
-	TopicID     string    `json:"topic_id" db:"topic_id"`
-	ContentType string    `json:"content_type" db:"content_type"`
-	Data        string    `json:"data" db:"data"`
+	ID             string    `json:"id" db:"id"`
+	TopicID        string    `json:"topic_id" db:"topic_id"`
+	ContentType    string    `json:"content_type" db:"content_type"`
+	Data           string    `json:"data" db:"data"`
+	Attributes     StringMap `json:"attributes" db:"attributes"`
+	IdempotencyKey string    `json:"idempotency_key" db:"idempotency_key"`
+	// OrderingKey deliveries are ordered by id, the ids are monotonic only inside one
+	// process so messages of the same key published by different instances on the same
+	// millisecond, or within the clock skew between hosts, may be delivered out of order
+	OrderingKey string    `json:"ordering_key" db:"ordering_key"`
+	EventType   string    `json:"event_type" db:"event_type"`
+	EventSource string    `json:"event_source" db:"event_source"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 }
 
@@ -105,27 +432,78 @@ func (m Message) Validate() error {
 		validation.Field(&m.TopicID, validation.Required),
 		validation.Field(&m.ContentType, validation.Required),
 		validation.Field(&m.Data, validation.Required),
+		validation.Field(&m.Attributes, validation.By(validateAttributes)),
+		validation.Field(&m.IdempotencyKey, validation.Length(0, 255)),
+		validation.Field(&m.OrderingKey, validation.Length(0, 255)),
+		validation.Field(&m.EventType, validation.Length(0, 255)),
 	)
 }
 
+// ValidateSchema validates the message data against the topic schema, each violation is reported
+// on the data field path ("data" for the root document)
+func (m Message) ValidateSchema(s *schema.Schema) error {
+	violations, err := s.Violations([]byte(m.Data))
+	if err != nil {
+		if errors.Is(err, schema.ErrInvalidData) {
+			return validation.Errors{"data": validation.NewError("validation_invalid_json", "must be a valid json document")}
+		}
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	errs := validation.Errors{}
+	for _, v := range violations {
+		field := "data"
+		if v.Field != "" {
+			field = field + "." + v.Field
+		}
+		if _, ok := errs[field]; !ok {
+			errs[field] = validation.NewError("validation_schema_violation", v.Description)
+		}
+	}
+	return errs
+}
+
 // Delivery data
 type Delivery struct {
-	ID                     string    `json:"id" db:"id"`
-	TopicID                string    `json:"topic_id" db:"topic_id"`
-	SubscriptionID         string    `json:"subscription_id" db:"subscription_id"`
-	MessageID              string    `json:"message_id" db:"message_id"`
-	ContentType            string    `json:"content_type" db:"content_type"`
-	Data                   string    `json:"data" db:"data"`
-	URL                    string    `json:"url" db:"url"`
-	SecretToken            string    `json:"secret_token" db:"secret_token"`
-	MaxDeliveryAttempts    int       `json:"max_delivery_attempts" db:"max_delivery_attempts"`
-	DeliveryAttemptDelay   int       `json:"delivery_attempt_delay" db:"delivery_attempt_delay"`
-	DeliveryAttemptTimeout int       `json:"delivery_attempt_timeout" db:"delivery_attempt_timeout"`
-	ScheduledAt            time.Time `json:"scheduled_at" db:"scheduled_at"`
-	DeliveryAttempts       int       `json:"delivery_attempts" db:"delivery_attempts"`
-	Status                 string    `json:"status" db:"status"`
-	CreatedAt              time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt              time.Time `json:"updated_at" db:"updated_at"`
+	ID                          string    `json:"id" db:"id"`
+	TopicID                     string    `json:"topic_id" db:"topic_id"`
+	SubscriptionID              string    `json:"subscription_id" db:"subscription_id"`
+	MessageID                   string    `json:"message_id" db:"message_id"`
+	ContentType                 string    `json:"content_type" db:"content_type"`
+	Data                        string    `json:"data" db:"data"`
+	URL                         string    `json:"url" db:"url"`
+	SecretToken                 string    `json:"secret_token" db:"secret_token"`
+	SigningMode                 string    `json:"signing_mode" db:"signing_mode"`
+	MaxDeliveryAttempts         int       `json:"max_delivery_attempts" db:"max_delivery_attempts"`
+	DeliveryAttemptDelay        int       `json:"delivery_attempt_delay" db:"delivery_attempt_delay"`
+	DeliveryAttemptTimeout      int       `json:"delivery_attempt_timeout" db:"delivery_attempt_timeout"`
+	RetryPolicy                 string    `json:"retry_policy" db:"retry_policy"`
+	RetryMaxDelay               int       `json:"retry_max_delay" db:"retry_max_delay"`
+	RetryJitter                 bool      `json:"retry_jitter" db:"retry_jitter"`
+	Attributes                  StringMap `json:"attributes" db:"attributes"`
+	DeadLetterTopicID           string    `json:"dead_letter_topic_id" db:"dead_letter_topic_id"`
+	OrderingKey                 string    `json:"ordering_key" db:"ordering_key"`
+	SuccessStatusCodes          string    `json:"success_status_codes" db:"success_status_codes"`
+	RedirectPolicy              string    `json:"redirect_policy" db:"redirect_policy"`
+	PermanentFailureStatusCodes string    `json:"permanent_failure_status_codes" db:"permanent_failure_status_codes"`
+	PauseOnGone                 bool      `json:"pause_on_gone" db:"pause_on_gone"`
+	Headers                     StringMap `json:"headers" db:"headers"`
+	HTTPMethod                  string    `json:"http_method" db:"http_method"`
+	PayloadFormat               string    `json:"payload_format" db:"payload_format"`
+	TransformTemplate           string    `json:"transform_template" db:"transform_template"`
+	TransformContentType        string    `json:"transform_content_type" db:"transform_content_type"`
+	EventType                   string    `json:"event_type" db:"event_type"`
+	EventSource                 string    `json:"event_source" db:"event_source"`
+	ScheduledAt                 time.Time `json:"scheduled_at" db:"scheduled_at"`
+	DeliveryAttempts            int       `json:"delivery_attempts" db:"delivery_attempts"`
+	ThrottledAttempts           int       `json:"throttled_attempts" db:"throttled_attempts"`
+	Status                      string    `json:"status" db:"status"`
+	LeaseOwner                  string    `json:"lease_owner" db:"lease_owner"`
+	LeaseExpiresAt              time.Time `json:"lease_expires_at" db:"lease_expires_at"`
+	CreatedAt                   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt                   time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // DeliveryAttempt data
@@ -141,19 +519,80 @@ type DeliveryAttempt struct {
 	CreatedAt          time.Time `json:"created_at" db:"created_at"`
 }
 
+// CircuitBreaker data, the state of the subscription endpoint
+type CircuitBreaker struct {
+	SubscriptionID      string    `json:"subscription_id" db:"subscription_id"`
+	State               string    `json:"state" db:"state"`
+	ConsecutiveFailures int       `json:"consecutive_failures" db:"consecutive_failures"`
+	OpenedAt            time.Time `json:"opened_at" db:"opened_at"`
+	UpdatedAt           time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// DeadLetterPayload is the data of the message published on the dead-letter topic when a delivery fails
+type DeadLetterPayload struct {
+	DeliveryID             string    `json:"delivery_id"`
+	SubscriptionID         string    `json:"subscription_id"`
+	TopicID                string    `json:"topic_id"`
+	MessageID              string    `json:"message_id"`
+	ContentType            string    `json:"content_type"`
+	DataBase64             string    `json:"data_base64"`
+	Attributes             StringMap `json:"attributes"`
+	DeliveryAttempts       int       `json:"delivery_attempts"`
+	LastDeliveryAttemptID  string    `json:"last_delivery_attempt_id"`
+	LastResponseStatusCode int       `json:"last_response_status_code"`
+	LastError              string    `json:"last_error"`
+	FailedAt               time.Time `json:"failed_at"`
+}
+
 // CloudEventPayload data
 type CloudEventPayload struct {
-	SpecVersion     string    `json:"specversion"`
-	Type            string    `json:"type"`
-	Source          string    `json:"source"`
-	ID              string    `json:"id"`
-	Time            time.Time `json:"time"`
-	SecretToken     string    `json:"secrettoken"`
-	MessageID       string    `json:"messageid"`
-	SubscriptionID  string    `json:"subscriptionid"`
-	TopicID         string    `json:"topicid"`
-	DataContentType string    `json:"datacontenttype"`
-	DataBase64      string    `json:"data_base64"`
+	SpecVersion     string          `json:"specversion"`
+	Type            string          `json:"type"`
+	Source          string          `json:"source"`
+	ID              string          `json:"id"`
+	Time            time.Time       `json:"time"`
+	SecretToken     string          `json:"secrettoken,omitempty"`
+	MessageID       string          `json:"messageid"`
+	SubscriptionID  string          `json:"subscriptionid"`
+	TopicID         string          `json:"topicid"`
+	DataContentType string          `json:"datacontenttype"`
+	DataBase64      string          `json:"data_base64,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	// Extensions are sent as CloudEvents extension attributes on the top level of the payload
+	Extensions map[string]string `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface adding the extensions after the default attributes
+func (c CloudEventPayload) MarshalJSON() ([]byte, error) {
+	type payload CloudEventPayload
+	data, err := json.Marshal(payload(c))
+	if err != nil || len(c.Extensions) == 0 {
+		return data, err
+	}
+
+	names := make([]string, 0, len(c.Extensions))
+	for name := range c.Extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bytes.NewBuffer(data[:len(data)-1])
+	for _, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(c.Extensions[name])
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
 }
 
 // FindFilter data
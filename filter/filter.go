@@ -0,0 +1,522 @@
+// Package filter implements the expression language used by subscriptions to select
+// which messages they receive.
+//
+// An expression compares fields of a document with literal values, for example:
+//
+//	data.type = "order.created" AND (data.total >= 100 OR NOT exists(data.coupon))
+//	attributes.region = "us" AND in(data.status, "paid", "shipped")
+//
+// Fields are dotted paths, array elements are accessed by index (data.items.0.sku).
+// Supported operators are =, !=, >, >=, <, <= and the functions exists(path) and
+// in(path, value, ...). Comparisons against a missing field are always false.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidExpression is used when the expression can't be parsed.
+var ErrInvalidExpression = errors.New("invalid_filter_expression")
+
+// Expression is a parsed filter expression
+type Expression struct {
+	root node
+}
+
+// Match reports whether the document matches the expression
+func (e *Expression) Match(document map[string]interface{}) bool {
+	return e.root.eval(document)
+}
+
+// Parse returns a new Expression from s
+func Parse(s string) (*Expression, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", p.peek().text)
+	}
+	return &Expression{root: root}, nil
+}
+
+// Validate returns an error if s is not a valid expression, an empty string is valid
+func Validate(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	_, err := Parse(s)
+	return err
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPath
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func isPathChar(c byte) bool {
+	return c == '_' || c == '-' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func tokenize(s string) ([]token, error) {
+	tokens := []token{}
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case c == '=':
+			tokens = append(tokens, token{kind: tokenOperator, text: "=", pos: i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(s) && s[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenOperator, text: s[i : i+2], pos: i})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidExpression, c, i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(s) && s[end] != c {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("%w: unterminated string at position %d", ErrInvalidExpression, i)
+			}
+			text := s[i : end+1]
+			if c == '\'' {
+				text = `"` + strings.ReplaceAll(strings.ReplaceAll(text[1:len(text)-1], `\'`, `'`), `"`, `\"`) + `"`
+			}
+			value, err := strconv.Unquote(text)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid string at position %d", ErrInvalidExpression, i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value, pos: i})
+			i = end + 1
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(s) && (s[end] == '.' || s[end] == 'e' || s[end] == 'E' || s[end] == '+' || s[end] == '-' || (s[end] >= '0' && s[end] <= '9')) {
+				end++
+			}
+			if _, err := strconv.ParseFloat(s[i:end], 64); err != nil {
+				return nil, fmt.Errorf("%w: invalid number at position %d", ErrInvalidExpression, i)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: s[i:end], pos: i})
+			i = end
+		case isPathChar(c):
+			end := i
+			for end < len(s) && isPathChar(s[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenPath, text: s[i:end], pos: i})
+			i = end
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidExpression, c, i)
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(s)})
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at position %d", ErrInvalidExpression, fmt.Sprintf(format, args...), p.peek().pos)
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenPath && strings.EqualFold(t.text, keyword)
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	if p.peek().kind != kind {
+		return p.errorf("expected %q", text)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isKeyword("not") {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.peek().kind == tokenLParen {
+		p.next()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+
+	if p.peek().kind != tokenPath {
+		return nil, p.errorf("expected field")
+	}
+
+	// Functions
+	if p.isKeyword("exists") && p.tokens[p.pos+1].kind == tokenLParen {
+		p.next()
+		p.next()
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return existsNode{path: path}, nil
+	}
+	if p.isKeyword("in") && p.tokens[p.pos+1].kind == tokenLParen {
+		p.next()
+		p.next()
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		values := []interface{}{}
+		for p.peek().kind == tokenComma {
+			p.next()
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		if len(values) == 0 {
+			return nil, p.errorf("expected %q", ",")
+		}
+		if err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return inNode{path: path, values: values}, nil
+	}
+
+	// Comparison
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenOperator {
+		return nil, p.errorf("expected operator")
+	}
+	operator := p.next().text
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return compareNode{path: path, operator: operator, value: value}, nil
+}
+
+func (p *parser) parsePath() ([]string, error) {
+	t := p.peek()
+	if t.kind != tokenPath {
+		return nil, p.errorf("expected field")
+	}
+	path := strings.Split(t.text, ".")
+	for _, segment := range path {
+		if segment == "" {
+			return nil, p.errorf("invalid field %q", t.text)
+		}
+	}
+	p.next()
+	return path, nil
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next()
+		return t.text, nil
+	case tokenNumber:
+		p.next()
+		f, _ := strconv.ParseFloat(t.text, 64)
+		return f, nil
+	case tokenPath:
+		switch strings.ToLower(t.text) {
+		case "true":
+			p.next()
+			return true, nil
+		case "false":
+			p.next()
+			return false, nil
+		case "null":
+			p.next()
+			return nil, nil
+		}
+	}
+	return nil, p.errorf("expected value")
+}
+
+type node interface {
+	eval(document map[string]interface{}) bool
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) eval(document map[string]interface{}) bool {
+	return n.left.eval(document) && n.right.eval(document)
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) eval(document map[string]interface{}) bool {
+	return n.left.eval(document) || n.right.eval(document)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(document map[string]interface{}) bool {
+	return !n.operand.eval(document)
+}
+
+type existsNode struct {
+	path []string
+}
+
+func (n existsNode) eval(document map[string]interface{}) bool {
+	_, ok := lookup(document, n.path)
+	return ok
+}
+
+type inNode struct {
+	path   []string
+	values []interface{}
+}
+
+func (n inNode) eval(document map[string]interface{}) bool {
+	field, ok := lookup(document, n.path)
+	if !ok {
+		return false
+	}
+	for _, value := range n.values {
+		if equal(field, value) {
+			return true
+		}
+	}
+	return false
+}
+
+type compareNode struct {
+	path     []string
+	operator string
+	value    interface{}
+}
+
+func (n compareNode) eval(document map[string]interface{}) bool {
+	field, ok := lookup(document, n.path)
+	if !ok {
+		return false
+	}
+
+	switch n.operator {
+	case "=":
+		return equal(field, n.value)
+	case "!=":
+		return !equal(field, n.value)
+	}
+
+	cmp, ok := compare(field, n.value)
+	if !ok {
+		return false
+	}
+	switch n.operator {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+func lookup(document map[string]interface{}, path []string) (interface{}, bool) {
+	var current interface{} = document
+	for _, segment := range path {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			v, ok := value[segment]
+			if !ok {
+				return nil, false
+			}
+			current = v
+		case map[string]string:
+			v, ok := value[segment]
+			if !ok {
+				return nil, false
+			}
+			current = v
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			current = value[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	switch va := a.(type) {
+	case string:
+		vb, ok := b.(string)
+		return ok && va == vb
+	case bool:
+		vb, ok := b.(bool)
+		return ok && va == vb
+	case nil:
+		return b == nil
+	}
+	return false
+}
+
+func compare(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	}
+	if sa, ok := a.(string); ok {
+		sb, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(sa, sb), true
+	}
+	return 0, false
+}
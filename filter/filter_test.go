@@ -0,0 +1,90 @@
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	document := map[string]interface{}{}
+	err := json.Unmarshal([]byte(`{
+		"content_type": "application/json",
+		"data": {
+			"type": "order.created",
+			"total": 150.5,
+			"paid": true,
+			"coupon": null,
+			"customer": {"country": "BR"},
+			"items": [{"sku": "A1"}, {"sku": "B2"}]
+		}
+	}`), &document)
+	assert.Nil(t, err)
+
+	tables := []struct {
+		expression string
+		match      bool
+	}{
+		{`data.type = "order.created"`, true},
+		{`data.type = 'order.created'`, true},
+		{`data.type != "order.created"`, false},
+		{`data.total > 100`, true},
+		{`data.total >= 150.5`, true},
+		{`data.total < 100`, false},
+		{`data.total <= -1`, false},
+		{`data.paid = true`, true},
+		{`data.coupon = null`, true},
+		{`data.customer.country = "BR"`, true},
+		{`data.items.1.sku = "B2"`, true},
+		{`data.items.2.sku = "C3"`, false},
+		{`data.missing = "value"`, false},
+		{`data.missing != "value"`, false},
+		{`data.type > 1`, false},
+		{`exists(data.coupon)`, true},
+		{`exists(data.discount)`, false},
+		{`in(data.customer.country, "AR", "BR")`, true},
+		{`in(data.customer.country, "AR", "US")`, false},
+		{`data.type = "order.created" AND data.total > 200`, false},
+		{`data.type = "order.created" and data.total > 200 or data.paid = true`, true},
+		{`data.type = "order.created" AND (data.total > 200 OR data.paid = false)`, false},
+		{`NOT data.paid = false`, true},
+		{`content_type = "application/json"`, true},
+	}
+
+	for _, table := range tables {
+		expression, err := Parse(table.expression)
+		assert.Nil(t, err, table.expression)
+		assert.Equal(t, table.match, expression.Match(document), table.expression)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	expressions := []string{
+		`data.type =`,
+		`data.type "order.created"`,
+		`= "order.created"`,
+		`data.type = "order.created`,
+		`(data.total > 1`,
+		`data.total > 1)`,
+		`data.total ! 1`,
+		`data..total > 1`,
+		`exists(data.total`,
+		`in(data.total)`,
+		`data.total > 1 AND`,
+		`data.total > 1e`,
+	}
+
+	for _, expression := range expressions {
+		_, err := Parse(expression)
+		assert.NotNil(t, err, expression)
+		assert.True(t, errors.Is(err, ErrInvalidExpression), expression)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate(""))
+	assert.Nil(t, Validate(`data.total > 1`))
+	assert.NotNil(t, Validate(`data.total >`))
+}
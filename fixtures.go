@@ -33,9 +33,11 @@ func MakeTestSubscription() Subscription {
 		Name:                   fmt.Sprintf("My Subscription %s", id),
 		URL:                    fmt.Sprintf("https://example.com/%s/", id),
 		SecretToken:            fmt.Sprintf("token-%s", id),
+		SigningMode:            SigningModeHMAC,
 		MaxDeliveryAttempts:    5,
 		DeliveryAttemptDelay:   60,
 		DeliveryAttemptTimeout: 5,
+		RetryPolicy:            RetryPolicyFixed,
 		CreatedAt:              time.Now().UTC(),
 		UpdatedAt:              time.Now().UTC(),
 	}
@@ -61,9 +63,11 @@ func MakeTestDelivery() Delivery {
 		Data:                   fmt.Sprintf("data_%s", id),
 		URL:                    fmt.Sprintf("https://example.com/%s/", id),
 		SecretToken:            fmt.Sprintf("token-%s", id),
+		SigningMode:            SigningModeHMAC,
 		MaxDeliveryAttempts:    5,
 		DeliveryAttemptDelay:   60,
 		DeliveryAttemptTimeout: 5,
+		RetryPolicy:            RetryPolicyFixed,
 		Status:                 "pending",
 		ScheduledAt:            time.Now().UTC(),
 		CreatedAt:              time.Now().UTC(),
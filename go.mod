@@ -5,7 +5,6 @@ go 1.14
 require (
 	github.com/DATA-DOG/go-txdb v0.1.3
 	github.com/allisson/go-env v0.2.0
-	github.com/allisson/go-pglock v1.1.0
 	github.com/go-ozzo/ozzo-validation/v4 v4.2.1
 	github.com/golang-migrate/migrate/v4 v4.11.0
 	github.com/golang/protobuf v1.4.2
@@ -20,6 +19,7 @@ require (
 	github.com/prometheus/client_golang v1.7.1
 	github.com/stretchr/testify v1.6.1
 	github.com/urfave/cli/v2 v2.2.0
+	github.com/xeipuuv/gojsonschema v1.2.0
 	go.uber.org/zap v1.15.0
 	golang.org/x/net v0.0.0-20200625001655-4c5254603344
 	google.golang.org/genproto v0.0.0-20200626011028-ee7919e894b5
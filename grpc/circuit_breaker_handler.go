@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/allisson/hammer"
+	pb "github.com/allisson/hammer/api/v1"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CircuitBreakerHandler implements methods for CircuitBreaker get
+type CircuitBreakerHandler struct {
+	circuitBreakerService hammer.CircuitBreakerService
+}
+
+func (c *CircuitBreakerHandler) buildResponse(circuitBreaker *hammer.CircuitBreaker) (*pb.CircuitBreaker, error) {
+	response := &pb.CircuitBreaker{}
+	openedAt, err := ptypes.TimestampProto(circuitBreaker.OpenedAt)
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	updatedAt, err := ptypes.TimestampProto(circuitBreaker.UpdatedAt)
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	response.SubscriptionId = circuitBreaker.SubscriptionID
+	response.State = circuitBreaker.State
+	response.ConsecutiveFailures = uint32(circuitBreaker.ConsecutiveFailures)
+	response.OpenedAt = openedAt
+	response.UpdatedAt = updatedAt
+
+	return response, nil
+}
+
+// GetCircuitBreaker gets the CircuitBreaker of the subscription
+func (c *CircuitBreakerHandler) GetCircuitBreaker(ctx context.Context, request *pb.GetCircuitBreakerRequest) (*pb.CircuitBreaker, error) {
+	// Get CircuitBreaker from service
+	circuitBreaker, err := c.circuitBreakerService.Find(request.SubscriptionId)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return &pb.CircuitBreaker{}, status.Error(codes.NotFound, hammer.ErrCircuitBreakerDoesNotExists.Error())
+		default:
+			return &pb.CircuitBreaker{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return c.buildResponse(&circuitBreaker)
+}
+
+// NewCircuitBreakerHandler returns a new CircuitBreakerHandler
+func NewCircuitBreakerHandler(circuitBreakerService hammer.CircuitBreakerService) CircuitBreakerHandler {
+	return CircuitBreakerHandler{circuitBreakerService: circuitBreakerService}
+}
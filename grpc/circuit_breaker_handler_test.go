@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/allisson/hammer"
+	pb "github.com/allisson/hammer/api/v1"
+	"github.com/allisson/hammer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreakerHandler(t *testing.T) {
+	t.Run("Test GetCircuitBreaker", func(t *testing.T) {
+		circuitBreakerService := &mocks.CircuitBreakerService{}
+		handler := NewCircuitBreakerHandler(circuitBreakerService)
+		ctx := context.Background()
+		circuitBreaker := hammer.CircuitBreaker{
+			SubscriptionID:      "subscription_id",
+			State:               hammer.CircuitBreakerStateOpen,
+			ConsecutiveFailures: 5,
+			OpenedAt:            time.Now().UTC(),
+			UpdatedAt:           time.Now().UTC(),
+		}
+		request := &pb.GetCircuitBreakerRequest{
+			SubscriptionId: "subscription_id",
+		}
+		circuitBreakerService.On("Find", mock.Anything).Return(circuitBreaker, nil)
+
+		response, err := handler.GetCircuitBreaker(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, "subscription_id", response.SubscriptionId)
+		assert.Equal(t, hammer.CircuitBreakerStateOpen, response.State)
+		assert.Equal(t, uint32(5), response.ConsecutiveFailures)
+	})
+
+	t.Run("Test GetCircuitBreaker with subscription does not exists", func(t *testing.T) {
+		circuitBreakerService := &mocks.CircuitBreakerService{}
+		handler := NewCircuitBreakerHandler(circuitBreakerService)
+		ctx := context.Background()
+		request := &pb.GetCircuitBreakerRequest{
+			SubscriptionId: "subscription_id",
+		}
+		circuitBreakerService.On("Find", mock.Anything).Return(hammer.CircuitBreaker{}, sql.ErrNoRows)
+
+		_, err := handler.GetCircuitBreaker(ctx, request)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
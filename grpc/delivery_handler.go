@@ -11,11 +11,50 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// DeliveryHandler implements methods for Delivery get/list
+// DeliveryHandler implements methods for Delivery get/list/redeliver/cancel
 type DeliveryHandler struct {
 	deliveryService hammer.DeliveryService
 }
 
+func deliveryFilters(topicID, subscriptionID, messageID, status string) []hammer.FindFilter {
+	findFilters := []hammer.FindFilter{}
+
+	if topicID != "" {
+		topicFilter := hammer.FindFilter{
+			FieldName: "topic_id",
+			Operator:  "=",
+			Value:     topicID,
+		}
+		findFilters = append(findFilters, topicFilter)
+	}
+	if subscriptionID != "" {
+		subscriptionFilter := hammer.FindFilter{
+			FieldName: "subscription_id",
+			Operator:  "=",
+			Value:     subscriptionID,
+		}
+		findFilters = append(findFilters, subscriptionFilter)
+	}
+	if messageID != "" {
+		messageFilter := hammer.FindFilter{
+			FieldName: "message_id",
+			Operator:  "=",
+			Value:     messageID,
+		}
+		findFilters = append(findFilters, messageFilter)
+	}
+	if status != "" {
+		statusFilter := hammer.FindFilter{
+			FieldName: "status",
+			Operator:  "=",
+			Value:     status,
+		}
+		findFilters = append(findFilters, statusFilter)
+	}
+
+	return findFilters
+}
+
 func (d *DeliveryHandler) buildResponse(delivery *hammer.Delivery) (*pb.Delivery, error) {
 	response := &pb.Delivery{}
 	createdAt, err := ptypes.TimestampProto(delivery.CreatedAt)
@@ -38,9 +77,27 @@ func (d *DeliveryHandler) buildResponse(delivery *hammer.Delivery) (*pb.Delivery
 	response.Data = delivery.Data
 	response.Url = delivery.URL
 	response.SecretToken = delivery.SecretToken
+	response.SigningMode = delivery.SigningMode
 	response.MaxDeliveryAttempts = uint32(delivery.MaxDeliveryAttempts)
 	response.DeliveryAttemptDelay = uint32(delivery.DeliveryAttemptDelay)
 	response.DeliveryAttemptTimeout = uint32(delivery.DeliveryAttemptTimeout)
+	response.RetryPolicy = delivery.RetryPolicy
+	response.RetryMaxDelay = uint32(delivery.RetryMaxDelay)
+	response.RetryJitter = delivery.RetryJitter
+	response.Attributes = delivery.Attributes
+	response.DeadLetterTopicId = delivery.DeadLetterTopicID
+	response.OrderingKey = delivery.OrderingKey
+	response.SuccessStatusCodes = delivery.SuccessStatusCodes
+	response.RedirectPolicy = delivery.RedirectPolicy
+	response.PermanentFailureStatusCodes = delivery.PermanentFailureStatusCodes
+	response.PauseOnGone = delivery.PauseOnGone
+	response.Headers = redactHeaders(delivery.Headers)
+	response.HttpMethod = delivery.HTTPMethod
+	response.PayloadFormat = delivery.PayloadFormat
+	response.TransformTemplate = delivery.TransformTemplate
+	response.TransformContentType = delivery.TransformContentType
+	response.EventType = delivery.EventType
+	response.EventSource = delivery.EventSource
 	response.ScheduledAt = scheduledAt
 	response.DeliveryAttempts = uint32(delivery.DeliveryAttempts)
 	response.Status = delivery.Status
@@ -81,38 +138,8 @@ func (d *DeliveryHandler) ListDeliveries(ctx context.Context, request *pb.ListDe
 			Offset: offset,
 		},
 	}
-	if request.TopicId != "" {
-		topicFilter := hammer.FindFilter{
-			FieldName: "topic_id",
-			Operator:  "=",
-			Value:     request.TopicId,
-		}
-		findOptions.FindFilters = append(findOptions.FindFilters, topicFilter)
-	}
-	if request.SubscriptionId != "" {
-		subscriptionFilter := hammer.FindFilter{
-			FieldName: "subscription_id",
-			Operator:  "=",
-			Value:     request.SubscriptionId,
-		}
-		findOptions.FindFilters = append(findOptions.FindFilters, subscriptionFilter)
-	}
-	if request.MessageId != "" {
-		messageFilter := hammer.FindFilter{
-			FieldName: "message_id",
-			Operator:  "=",
-			Value:     request.MessageId,
-		}
-		findOptions.FindFilters = append(findOptions.FindFilters, messageFilter)
-	}
-	if request.Status != "" {
-		statusFilter := hammer.FindFilter{
-			FieldName: "status",
-			Operator:  "=",
-			Value:     request.Status,
-		}
-		findOptions.FindFilters = append(findOptions.FindFilters, statusFilter)
-	}
+	deliveryFilters := deliveryFilters(request.TopicId, request.SubscriptionId, request.MessageId, request.Status)
+	findOptions.FindFilters = append(findOptions.FindFilters, deliveryFilters...)
 	createdAtFilters := createdAtFilters(request.CreatedAtGt, request.CreatedAtGte, request.CreatedAtLt, request.CreatedAtLte)
 	findOptions.FindFilters = append(findOptions.FindFilters, createdAtFilters...)
 	deliveries, err := d.deliveryService.FindAll(findOptions)
@@ -132,6 +159,84 @@ func (d *DeliveryHandler) ListDeliveries(ctx context.Context, request *pb.ListDe
 	return response, nil
 }
 
+// RedeliverDelivery schedules the delivery to be dispatched again
+func (d *DeliveryHandler) RedeliverDelivery(ctx context.Context, request *pb.RedeliverDeliveryRequest) (*pb.Delivery, error) {
+	delivery, err := d.deliveryService.Redeliver(request.Id, int(request.ExtraAttempts))
+	if err != nil {
+		switch err {
+		case hammer.ErrDeliveryDoesNotExists:
+			return &pb.Delivery{}, status.Error(codes.NotFound, err.Error())
+		case hammer.ErrDeliveryCannotBeRedelivered:
+			return &pb.Delivery{}, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return &pb.Delivery{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return d.buildResponse(&delivery)
+}
+
+// RedeliverDeliveries schedules the filtered deliveries to be dispatched again
+func (d *DeliveryHandler) RedeliverDeliveries(ctx context.Context, request *pb.RedeliverDeliveriesRequest) (*pb.RedeliverDeliveriesResponse, error) {
+	// Create response
+	response := &pb.RedeliverDeliveriesResponse{}
+
+	// Get filters, at least one is required to avoid redeliver everything
+	findOptions := hammer.FindOptions{}
+	deliveryFilters := deliveryFilters(request.TopicId, request.SubscriptionId, request.MessageId, request.Status)
+	findOptions.FindFilters = append(findOptions.FindFilters, deliveryFilters...)
+	createdAtFilters := createdAtFilters(request.CreatedAtGt, request.CreatedAtGte, request.CreatedAtLt, request.CreatedAtLte)
+	findOptions.FindFilters = append(findOptions.FindFilters, createdAtFilters...)
+	if len(findOptions.FindFilters) == 0 {
+		return response, status.Error(codes.InvalidArgument, "missing_filters")
+	}
+
+	// Redeliver deliveries
+	deliveries, err := d.deliveryService.RedeliverAll(findOptions, int(request.ExtraAttempts))
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	response.Deliveries = uint32(deliveries)
+
+	return response, nil
+}
+
+// CancelDelivery stops a pending delivery from being dispatched
+func (d *DeliveryHandler) CancelDelivery(ctx context.Context, request *pb.CancelDeliveryRequest) (*pb.Delivery, error) {
+	delivery, err := d.deliveryService.Cancel(request.Id)
+	if err != nil {
+		switch err {
+		case hammer.ErrDeliveryDoesNotExists:
+			return &pb.Delivery{}, status.Error(codes.NotFound, err.Error())
+		case hammer.ErrDeliveryIsNotPending:
+			return &pb.Delivery{}, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return &pb.Delivery{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return d.buildResponse(&delivery)
+}
+
+// CancelDeliveries stops all pending deliveries of a message from being dispatched
+func (d *DeliveryHandler) CancelDeliveries(ctx context.Context, request *pb.CancelDeliveriesRequest) (*pb.CancelDeliveriesResponse, error) {
+	// Create response
+	response := &pb.CancelDeliveriesResponse{}
+
+	if request.MessageId == "" {
+		return response, status.Error(codes.InvalidArgument, "missing_message_id")
+	}
+
+	// Cancel deliveries
+	deliveries, err := d.deliveryService.CancelByMessage(request.MessageId)
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	response.Deliveries = uint32(deliveries)
+
+	return response, nil
+}
+
 // NewDeliveryHandler returns a new Delivery
 func NewDeliveryHandler(deliveryService hammer.DeliveryService) DeliveryHandler {
 	return DeliveryHandler{deliveryService: deliveryService}
@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestDeliveryHandler(t *testing.T) {
@@ -21,6 +23,7 @@ func TestDeliveryHandler(t *testing.T) {
 			ID:        "id",
 			TopicID:   "topic_id",
 			Data:      "{}",
+			Headers:   hammer.StringMap{"Authorization": "Bearer secret", "X-Tenant-Id": "tenant"},
 			CreatedAt: time.Now().UTC(),
 		}
 		request := &pb.GetDeliveryRequest{
@@ -32,6 +35,7 @@ func TestDeliveryHandler(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "id", response.Id)
 		assert.Equal(t, "{}", response.Data)
+		assert.Equal(t, map[string]string{"Authorization": "[REDACTED]", "X-Tenant-Id": "tenant"}, response.Headers)
 	})
 
 	t.Run("Test ListDeliveries", func(t *testing.T) {
@@ -56,4 +60,114 @@ func TestDeliveryHandler(t *testing.T) {
 		assert.Equal(t, "id", response.Deliveries[0].Id)
 		assert.Equal(t, "{}", response.Deliveries[0].Data)
 	})
+
+	t.Run("Test RedeliverDelivery", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		delivery := hammer.Delivery{
+			ID:        "id",
+			TopicID:   "topic_id",
+			Data:      "{}",
+			Status:    hammer.DeliveryStatusPending,
+			CreatedAt: time.Now().UTC(),
+		}
+		request := &pb.RedeliverDeliveryRequest{
+			Id:            "id",
+			ExtraAttempts: 1,
+		}
+		deliveryService.On("Redeliver", "id", 1).Return(delivery, nil)
+
+		response, err := handler.RedeliverDelivery(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, "id", response.Id)
+		assert.Equal(t, hammer.DeliveryStatusPending, response.Status)
+	})
+
+	t.Run("Test RedeliverDelivery with delivery does not exists", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		request := &pb.RedeliverDeliveryRequest{
+			Id: "id",
+		}
+		deliveryService.On("Redeliver", "id", 0).Return(hammer.Delivery{}, hammer.ErrDeliveryDoesNotExists)
+
+		_, err := handler.RedeliverDelivery(ctx, request)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Test RedeliverDeliveries", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		request := &pb.RedeliverDeliveriesRequest{
+			SubscriptionId: "subscription_id",
+			Status:         hammer.DeliveryStatusFailed,
+		}
+		deliveryService.On("RedeliverAll", mock.Anything, 0).Return(2, nil)
+
+		response, err := handler.RedeliverDeliveries(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), response.Deliveries)
+	})
+
+	t.Run("Test RedeliverDeliveries without filters", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		request := &pb.RedeliverDeliveriesRequest{}
+
+		_, err := handler.RedeliverDeliveries(ctx, request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		deliveryService.AssertNotCalled(t, "RedeliverAll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test CancelDelivery", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		delivery := hammer.Delivery{
+			ID:        "id",
+			TopicID:   "topic_id",
+			Data:      "{}",
+			Status:    hammer.DeliveryStatusCancelled,
+			CreatedAt: time.Now().UTC(),
+		}
+		request := &pb.CancelDeliveryRequest{
+			Id: "id",
+		}
+		deliveryService.On("Cancel", "id").Return(delivery, nil)
+
+		response, err := handler.CancelDelivery(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusCancelled, response.Status)
+	})
+
+	t.Run("Test CancelDelivery with delivery is not pending", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		request := &pb.CancelDeliveryRequest{
+			Id: "id",
+		}
+		deliveryService.On("Cancel", "id").Return(hammer.Delivery{}, hammer.ErrDeliveryIsNotPending)
+
+		_, err := handler.CancelDelivery(ctx, request)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Test CancelDeliveries", func(t *testing.T) {
+		deliveryService := &mocks.DeliveryService{}
+		handler := NewDeliveryHandler(deliveryService)
+		ctx := context.Background()
+		request := &pb.CancelDeliveriesRequest{
+			MessageId: "message_id",
+		}
+		deliveryService.On("CancelByMessage", "message_id").Return(3, nil)
+
+		response, err := handler.CancelDeliveries(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), response.Deliveries)
+	})
 }
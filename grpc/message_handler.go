@@ -6,6 +6,7 @@ import (
 
 	"github.com/allisson/hammer"
 	pb "github.com/allisson/hammer/api/v1"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -27,6 +28,11 @@ func (m *MessageHandler) buildResponse(message *hammer.Message) (*pb.Message, er
 	response.TopicId = message.TopicID
 	response.ContentType = message.ContentType
 	response.Data = message.Data
+	response.Attributes = message.Attributes
+	response.IdempotencyKey = message.IdempotencyKey
+	response.OrderingKey = message.OrderingKey
+	response.EventType = message.EventType
+	response.EventSource = message.EventSource
 	response.CreatedAt = createdAt
 
 	return response, nil
@@ -40,10 +46,17 @@ func (m *MessageHandler) CreateMessage(ctx context.Context, request *pb.CreateMe
 
 	// Build a message
 	message := hammer.Message{
-		ID:          request.Message.Id,
-		TopicID:     request.Message.TopicId,
-		ContentType: request.Message.ContentType,
-		Data:        string(request.Message.Data),
+		ID:             request.Message.Id,
+		TopicID:        request.Message.TopicId,
+		ContentType:    request.Message.ContentType,
+		Data:           string(request.Message.Data),
+		Attributes:     request.Message.Attributes,
+		IdempotencyKey: request.IdempotencyKey,
+		OrderingKey:    request.Message.OrderingKey,
+		EventType:      request.Message.EventType,
+	}
+	if message.IdempotencyKey == "" {
+		message.IdempotencyKey = request.Message.IdempotencyKey
 	}
 
 	// Validate message
@@ -56,12 +69,92 @@ func (m *MessageHandler) CreateMessage(ctx context.Context, request *pb.CreateMe
 	// Create Message
 	err = m.messageService.Create(&message)
 	if err != nil {
+		if _, ok := err.(validation.Errors); ok {
+			st := validationStatusError(codes.InvalidArgument, "invalid_message", err)
+			return &pb.Message{}, st.Err()
+		}
 		return &pb.Message{}, status.Error(codes.Internal, err.Error())
 	}
 
 	return m.buildResponse(&message)
 }
 
+// CreateMessages creates a batch of messages, the response has a result for each message of the request
+func (m *MessageHandler) CreateMessages(ctx context.Context, request *pb.CreateMessagesRequest) (*pb.CreateMessagesResponse, error) {
+	// Create response
+	response := &pb.CreateMessagesResponse{}
+
+	if len(request.Messages) == 0 || len(request.Messages) > hammer.MaxBatchSize {
+		return response, status.Error(codes.InvalidArgument, "invalid_batch_size")
+	}
+
+	// Build and validate messages
+	results := make([]*pb.CreateMessagesResult, len(request.Messages))
+	messages := []*hammer.Message{}
+	indexes := []int{}
+	for i, requestMessage := range request.Messages {
+		results[i] = &pb.CreateMessagesResult{}
+		if requestMessage == nil {
+			requestMessage = &pb.Message{}
+		}
+		message := hammer.Message{
+			TopicID:        requestMessage.TopicId,
+			ContentType:    requestMessage.ContentType,
+			Data:           string(requestMessage.Data),
+			Attributes:     requestMessage.Attributes,
+			IdempotencyKey: requestMessage.IdempotencyKey,
+			OrderingKey:    requestMessage.OrderingKey,
+			EventType:      requestMessage.EventType,
+		}
+		err := message.Validate()
+		if err != nil {
+			st := validationStatusError(codes.InvalidArgument, "invalid_message", err)
+			results[i].Status = st.Proto()
+			continue
+		}
+		messages = append(messages, &message)
+		indexes = append(indexes, i)
+	}
+
+	// Create messages
+	if len(messages) > 0 {
+		errs, err := m.messageService.CreateBatch(messages)
+		if err != nil {
+			switch err {
+			case hammer.ErrMessageIdempotencyKeyConflict:
+				return response, status.Error(codes.Aborted, err.Error())
+			default:
+				return response, status.Error(codes.Internal, err.Error())
+			}
+		}
+		for j, message := range messages {
+			result := results[indexes[j]]
+			switch errs[j] {
+			case nil:
+				messageResponse, err := m.buildResponse(message)
+				if err != nil {
+					return response, err
+				}
+				result.Message = messageResponse
+				result.Status = status.New(codes.OK, "").Proto()
+			case hammer.ErrTopicDoesNotExists:
+				result.Status = status.New(codes.NotFound, errs[j].Error()).Proto()
+			case hammer.ErrMessageIdempotencyKeyConflict:
+				result.Status = status.New(codes.Aborted, errs[j].Error()).Proto()
+			default:
+				if _, ok := errs[j].(validation.Errors); ok {
+					result.Status = validationStatusError(codes.InvalidArgument, "invalid_message", errs[j]).Proto()
+					continue
+				}
+				result.Status = status.New(codes.Internal, errs[j].Error()).Proto()
+			}
+		}
+	}
+	response.Results = results
+
+	return response, nil
+}
+
 // GetMessage gets the message
 func (m *MessageHandler) GetMessage(ctx context.Context, request *pb.GetMessageRequest) (*pb.Message, error) {
 	// Get nessage from service
@@ -7,10 +7,14 @@ import (
 	"github.com/allisson/hammer"
 	pb "github.com/allisson/hammer/api/v1"
 	"github.com/allisson/hammer/mocks"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMessageHandler(t *testing.T) {
@@ -34,6 +38,145 @@ func TestMessageHandler(t *testing.T) {
 		assert.Equal(t, "{}", response.Data)
 	})
 
+	t.Run("Test CreateMessage with topic schema violation", func(t *testing.T) {
+		messageService := &mocks.MessageService{}
+		handler := NewMessageHandler(messageService)
+		ctx := context.Background()
+		request := &pb.CreateMessageRequest{
+			Message: &pb.Message{
+				TopicId:     "topic_id",
+				ContentType: "application/json",
+				Data:        "{}",
+			},
+		}
+		messageService.On("Create", mock.Anything).Return(validation.Errors{
+			"data.id": validation.NewError("validation_schema_violation", "id is required"),
+		})
+
+		_, err := handler.CreateMessage(ctx, request)
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid_message", st.Message())
+		br := st.Details()[0].(*errdetails.BadRequest)
+		assert.Equal(t, "data.id", br.FieldViolations[0].Field)
+		assert.Equal(t, "id is required", br.FieldViolations[0].Description)
+	})
+
+	t.Run("Test CreateMessage with attributes", func(t *testing.T) {
+		messageService := &mocks.MessageService{}
+		handler := NewMessageHandler(messageService)
+		ctx := context.Background()
+		request := &pb.CreateMessageRequest{
+			Message: &pb.Message{
+				TopicId:     "topic_id",
+				ContentType: "application/json",
+				Data:        "{}",
+				Attributes:  map[string]string{"traceid": "trace"},
+			},
+		}
+		messageService.On("Create", mock.Anything).Return(nil)
+
+		response, err := handler.CreateMessage(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, "trace", response.Attributes["traceid"])
+	})
+
+	t.Run("Test CreateMessage with idempotency key", func(t *testing.T) {
+		messageService := &mocks.MessageService{}
+		handler := NewMessageHandler(messageService)
+		ctx := context.Background()
+		request := &pb.CreateMessageRequest{
+			Message: &pb.Message{
+				TopicId:     "topic_id",
+				ContentType: "application/json",
+				Data:        "{}",
+			},
+			IdempotencyKey: "key",
+		}
+		messageService.On("Create", mock.MatchedBy(func(message *hammer.Message) bool {
+			return message.IdempotencyKey == "key"
+		})).Return(nil)
+
+		response, err := handler.CreateMessage(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, "key", response.IdempotencyKey)
+	})
+
+	t.Run("Test CreateMessage with invalid attributes", func(t *testing.T) {
+		messageService := &mocks.MessageService{}
+		handler := NewMessageHandler(messageService)
+		ctx := context.Background()
+		tables := []map[string]string{
+			{"TraceID": "trace"},
+			{"trace_id": "trace"},
+			{"source": "other"},
+		}
+
+		for _, attributes := range tables {
+			request := &pb.CreateMessageRequest{
+				Message: &pb.Message{
+					TopicId:     "topic_id",
+					ContentType: "application/json",
+					Data:        "{}",
+					Attributes:  attributes,
+				},
+			}
+
+			_, err := handler.CreateMessage(ctx, request)
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			fieldViolations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+			assert.Equal(t, "attributes", fieldViolations[0].Field)
+		}
+		messageService.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("Test CreateMessages", func(t *testing.T) {
+		messageService := &mocks.MessageService{}
+		handler := NewMessageHandler(messageService)
+		ctx := context.Background()
+		request := &pb.CreateMessagesRequest{
+			Messages: []*pb.Message{
+				{
+					TopicId:     "topic_id",
+					ContentType: "application/json",
+					Data:        "{}",
+				},
+				{
+					TopicId:     "topic_id",
+					ContentType: "application/json",
+				},
+				{
+					TopicId:     "other_topic_id",
+					ContentType: "application/json",
+					Data:        "{}",
+				},
+			},
+		}
+		messageService.On("CreateBatch", mock.MatchedBy(func(messages []*hammer.Message) bool {
+			return len(messages) == 2
+		})).Return([]error{nil, hammer.ErrTopicDoesNotExists}, nil)
+
+		response, err := handler.CreateMessages(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(response.Results))
+		assert.Equal(t, int32(codes.OK), response.Results[0].Status.Code)
+		assert.Equal(t, "{}", response.Results[0].Message.Data)
+		assert.Equal(t, int32(codes.InvalidArgument), response.Results[1].Status.Code)
+		assert.Nil(t, response.Results[1].Message)
+		assert.Equal(t, int32(codes.NotFound), response.Results[2].Status.Code)
+	})
+
+	t.Run("Test CreateMessages with empty batch", func(t *testing.T) {
+		messageService := &mocks.MessageService{}
+		handler := NewMessageHandler(messageService)
+		ctx := context.Background()
+		request := &pb.CreateMessagesRequest{}
+
+		_, err := handler.CreateMessages(ctx, request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("Test GetMessage", func(t *testing.T) {
 		messageService := &mocks.MessageService{}
 		handler := NewMessageHandler(messageService)
@@ -0,0 +1,18 @@
+package grpc
+
+import "github.com/allisson/hammer"
+
+// redactHeaders returns the headers with the values of hammer.RedactedHeaders redacted
+func redactHeaders(headers hammer.StringMap) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if hammer.IsRedactedHeader(name) {
+			value = hammer.RedactedHeaderValue
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
@@ -10,10 +10,12 @@ import (
 // Server implements grpc server
 type Server struct {
 	topicHandler           TopicHandler
+	topicSchemaHandler     TopicSchemaHandler
 	subscriptionHandler    SubscriptionHandler
 	messageHandler         MessageHandler
 	deliveryHandler        DeliveryHandler
 	deliveryAttemptHandler DeliveryAttemptHandler
+	circuitBreakerHandler  CircuitBreakerHandler
 }
 
 // CreateTopic creates a new topic
@@ -41,6 +43,16 @@ func (s *Server) DeleteTopic(ctx context.Context, request *pb.DeleteTopicRequest
 	return s.topicHandler.DeleteTopic(ctx, request)
 }
 
+// GetTopicSchema gets the topic schema
+func (s *Server) GetTopicSchema(ctx context.Context, request *pb.GetTopicSchemaRequest) (*pb.TopicSchema, error) {
+	return s.topicSchemaHandler.GetTopicSchema(ctx, request)
+}
+
+// UpdateTopicSchema creates a new version of the topic schema
+func (s *Server) UpdateTopicSchema(ctx context.Context, request *pb.UpdateTopicSchemaRequest) (*pb.TopicSchema, error) {
+	return s.topicSchemaHandler.UpdateTopicSchema(ctx, request)
+}
+
 // CreateSubscription creates a new subscription
 func (s *Server) CreateSubscription(ctx context.Context, request *pb.CreateSubscriptionRequest) (*pb.Subscription, error) {
 	return s.subscriptionHandler.CreateSubscription(ctx, request)
@@ -61,6 +73,21 @@ func (s *Server) ListSubscriptions(ctx context.Context, request *pb.ListSubscrip
 	return s.subscriptionHandler.ListSubscriptions(ctx, request)
 }
 
+// PauseSubscription pause the subscription
+func (s *Server) PauseSubscription(ctx context.Context, request *pb.PauseSubscriptionRequest) (*pb.Subscription, error) {
+	return s.subscriptionHandler.PauseSubscription(ctx, request)
+}
+
+// ResumeSubscription resume the subscription
+func (s *Server) ResumeSubscription(ctx context.Context, request *pb.ResumeSubscriptionRequest) (*pb.Subscription, error) {
+	return s.subscriptionHandler.ResumeSubscription(ctx, request)
+}
+
+// PreviewSubscriptionTransform renders the subscription transform template with a sample message
+func (s *Server) PreviewSubscriptionTransform(ctx context.Context, request *pb.PreviewSubscriptionTransformRequest) (*pb.Message, error) {
+	return s.subscriptionHandler.PreviewSubscriptionTransform(ctx, request)
+}
+
 // DeleteSubscription delete the subscription
 func (s *Server) DeleteSubscription(ctx context.Context, request *pb.DeleteSubscriptionRequest) (*empty.Empty, error) {
 	return s.subscriptionHandler.DeleteSubscription(ctx, request)
@@ -71,6 +98,11 @@ func (s *Server) CreateMessage(ctx context.Context, request *pb.CreateMessageReq
 	return s.messageHandler.CreateMessage(ctx, request)
 }
 
+// CreateMessages creates a batch of messages
+func (s *Server) CreateMessages(ctx context.Context, request *pb.CreateMessagesRequest) (*pb.CreateMessagesResponse, error) {
+	return s.messageHandler.CreateMessages(ctx, request)
+}
+
 // GetMessage gets the message
 func (s *Server) GetMessage(ctx context.Context, request *pb.GetMessageRequest) (*pb.Message, error) {
 	return s.messageHandler.GetMessage(ctx, request)
@@ -96,6 +128,26 @@ func (s *Server) ListDeliveries(ctx context.Context, request *pb.ListDeliveriesR
 	return s.deliveryHandler.ListDeliveries(ctx, request)
 }
 
+// RedeliverDelivery redeliver the delivery
+func (s *Server) RedeliverDelivery(ctx context.Context, request *pb.RedeliverDeliveryRequest) (*pb.Delivery, error) {
+	return s.deliveryHandler.RedeliverDelivery(ctx, request)
+}
+
+// RedeliverDeliveries redeliver a list of deliveries
+func (s *Server) RedeliverDeliveries(ctx context.Context, request *pb.RedeliverDeliveriesRequest) (*pb.RedeliverDeliveriesResponse, error) {
+	return s.deliveryHandler.RedeliverDeliveries(ctx, request)
+}
+
+// CancelDelivery cancel the delivery
+func (s *Server) CancelDelivery(ctx context.Context, request *pb.CancelDeliveryRequest) (*pb.Delivery, error) {
+	return s.deliveryHandler.CancelDelivery(ctx, request)
+}
+
+// CancelDeliveries cancel the pending deliveries of a message
+func (s *Server) CancelDeliveries(ctx context.Context, request *pb.CancelDeliveriesRequest) (*pb.CancelDeliveriesResponse, error) {
+	return s.deliveryHandler.CancelDeliveries(ctx, request)
+}
+
 // GetDeliveryAttempt gets the delivery attempt
 func (s *Server) GetDeliveryAttempt(ctx context.Context, request *pb.GetDeliveryAttemptRequest) (*pb.DeliveryAttempt, error) {
 	return s.deliveryAttemptHandler.GetDeliveryAttempt(ctx, request)
@@ -106,13 +158,20 @@ func (s *Server) ListDeliveryAttempts(ctx context.Context, request *pb.ListDeliv
 	return s.deliveryAttemptHandler.ListDeliveryAttempts(ctx, request)
 }
 
+// GetCircuitBreaker gets the circuit breaker of the subscription
+func (s *Server) GetCircuitBreaker(ctx context.Context, request *pb.GetCircuitBreakerRequest) (*pb.CircuitBreaker, error) {
+	return s.circuitBreakerHandler.GetCircuitBreaker(ctx, request)
+}
+
 // NewServer returns a new server
-func NewServer(topicHandler TopicHandler, subscriptionHandler SubscriptionHandler, messageHandler MessageHandler, deliveryHandler DeliveryHandler, deliveryAttemptHandler DeliveryAttemptHandler) Server {
+func NewServer(topicHandler TopicHandler, topicSchemaHandler TopicSchemaHandler, subscriptionHandler SubscriptionHandler, messageHandler MessageHandler, deliveryHandler DeliveryHandler, deliveryAttemptHandler DeliveryAttemptHandler, circuitBreakerHandler CircuitBreakerHandler) Server {
 	return Server{
 		topicHandler:           topicHandler,
+		topicSchemaHandler:     topicSchemaHandler,
 		subscriptionHandler:    subscriptionHandler,
 		messageHandler:         messageHandler,
 		deliveryHandler:        deliveryHandler,
 		deliveryAttemptHandler: deliveryAttemptHandler,
+		circuitBreakerHandler:  circuitBreakerHandler,
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/allisson/hammer"
 	pb "github.com/allisson/hammer/api/v1"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -32,9 +33,30 @@ func (s *SubscriptionHandler) buildResponse(subscription *hammer.Subscription) (
 	response.Name = subscription.Name
 	response.Url = subscription.URL
 	response.SecretToken = subscription.SecretToken
+	response.SigningMode = subscription.SigningMode
 	response.MaxDeliveryAttempts = uint32(subscription.MaxDeliveryAttempts)
 	response.DeliveryAttemptDelay = uint32(subscription.DeliveryAttemptDelay)
 	response.DeliveryAttemptTimeout = uint32(subscription.DeliveryAttemptTimeout)
+	response.RetryPolicy = subscription.RetryPolicy
+	response.RetryMaxDelay = uint32(subscription.RetryMaxDelay)
+	response.RetryJitter = subscription.RetryJitter
+	response.Paused = subscription.Paused
+	response.FilterExpression = subscription.FilterExpression
+	response.DeadLetterTopicId = subscription.DeadLetterTopicID
+	response.MaxConcurrency = uint32(subscription.MaxConcurrency)
+	response.RateLimit = uint32(subscription.RateLimit)
+	response.RateLimitBurst = uint32(subscription.RateLimitBurst)
+	response.OrderingEnabled = subscription.OrderingEnabled
+	response.OrderingFailurePolicy = subscription.OrderingFailurePolicy
+	response.SuccessStatusCodes = subscription.SuccessStatusCodes
+	response.RedirectPolicy = subscription.RedirectPolicy
+	response.PermanentFailureStatusCodes = subscription.PermanentFailureStatusCodes
+	response.PauseOnGone = subscription.PauseOnGone
+	response.Headers = redactHeaders(subscription.Headers)
+	response.HttpMethod = subscription.HTTPMethod
+	response.PayloadFormat = subscription.PayloadFormat
+	response.TransformTemplate = subscription.TransformTemplate
+	response.TransformContentType = subscription.TransformContentType
 	response.CreatedAt = createdAt
 	response.UpdatedAt = updatedAt
 
@@ -49,14 +71,34 @@ func (s *SubscriptionHandler) CreateSubscription(ctx context.Context, request *p
 
 	// Build a subscription
 	subscription := hammer.Subscription{
-		ID:                     request.Subscription.Id,
-		TopicID:                request.Subscription.TopicId,
-		Name:                   request.Subscription.Name,
-		URL:                    request.Subscription.Url,
-		SecretToken:            request.Subscription.SecretToken,
-		MaxDeliveryAttempts:    int(request.Subscription.MaxDeliveryAttempts),
-		DeliveryAttemptDelay:   int(request.Subscription.DeliveryAttemptDelay),
-		DeliveryAttemptTimeout: int(request.Subscription.DeliveryAttemptTimeout),
+		ID:                          request.Subscription.Id,
+		TopicID:                     request.Subscription.TopicId,
+		Name:                        request.Subscription.Name,
+		URL:                         request.Subscription.Url,
+		SecretToken:                 request.Subscription.SecretToken,
+		SigningMode:                 request.Subscription.SigningMode,
+		MaxDeliveryAttempts:         int(request.Subscription.MaxDeliveryAttempts),
+		DeliveryAttemptDelay:        int(request.Subscription.DeliveryAttemptDelay),
+		DeliveryAttemptTimeout:      int(request.Subscription.DeliveryAttemptTimeout),
+		RetryPolicy:                 request.Subscription.RetryPolicy,
+		RetryMaxDelay:               int(request.Subscription.RetryMaxDelay),
+		RetryJitter:                 request.Subscription.RetryJitter,
+		FilterExpression:            request.Subscription.FilterExpression,
+		DeadLetterTopicID:           request.Subscription.DeadLetterTopicId,
+		MaxConcurrency:              int(request.Subscription.MaxConcurrency),
+		RateLimit:                   int(request.Subscription.RateLimit),
+		RateLimitBurst:              int(request.Subscription.RateLimitBurst),
+		OrderingEnabled:             request.Subscription.OrderingEnabled,
+		OrderingFailurePolicy:       request.Subscription.OrderingFailurePolicy,
+		SuccessStatusCodes:          request.Subscription.SuccessStatusCodes,
+		RedirectPolicy:              request.Subscription.RedirectPolicy,
+		PermanentFailureStatusCodes: request.Subscription.PermanentFailureStatusCodes,
+		PauseOnGone:                 request.Subscription.PauseOnGone,
+		Headers:                     request.Subscription.Headers,
+		HTTPMethod:                  request.Subscription.HttpMethod,
+		PayloadFormat:               request.Subscription.PayloadFormat,
+		TransformTemplate:           request.Subscription.TransformTemplate,
+		TransformContentType:        request.Subscription.TransformContentType,
 	}
 
 	// Validate subscription
@@ -69,7 +111,12 @@ func (s *SubscriptionHandler) CreateSubscription(ctx context.Context, request *p
 	// Create subscription
 	err = s.subscriptionService.Create(&subscription)
 	if err != nil {
-		return &pb.Subscription{}, status.Error(codes.Internal, err.Error())
+		switch err {
+		case hammer.ErrDeadLetterTopicDoesNotExists, hammer.ErrDeadLetterTopicCycle:
+			return &pb.Subscription{}, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return &pb.Subscription{}, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return s.buildResponse(&subscription)
@@ -83,14 +130,34 @@ func (s *SubscriptionHandler) UpdateSubscription(ctx context.Context, request *p
 
 	// Build a subscription
 	subscription := hammer.Subscription{
-		ID:                     request.Subscription.Id,
-		TopicID:                request.Subscription.TopicId,
-		Name:                   request.Subscription.Name,
-		URL:                    request.Subscription.Url,
-		SecretToken:            request.Subscription.SecretToken,
-		MaxDeliveryAttempts:    int(request.Subscription.MaxDeliveryAttempts),
-		DeliveryAttemptDelay:   int(request.Subscription.DeliveryAttemptDelay),
-		DeliveryAttemptTimeout: int(request.Subscription.DeliveryAttemptTimeout),
+		ID:                          request.Subscription.Id,
+		TopicID:                     request.Subscription.TopicId,
+		Name:                        request.Subscription.Name,
+		URL:                         request.Subscription.Url,
+		SecretToken:                 request.Subscription.SecretToken,
+		SigningMode:                 request.Subscription.SigningMode,
+		MaxDeliveryAttempts:         int(request.Subscription.MaxDeliveryAttempts),
+		DeliveryAttemptDelay:        int(request.Subscription.DeliveryAttemptDelay),
+		DeliveryAttemptTimeout:      int(request.Subscription.DeliveryAttemptTimeout),
+		RetryPolicy:                 request.Subscription.RetryPolicy,
+		RetryMaxDelay:               int(request.Subscription.RetryMaxDelay),
+		RetryJitter:                 request.Subscription.RetryJitter,
+		FilterExpression:            request.Subscription.FilterExpression,
+		DeadLetterTopicID:           request.Subscription.DeadLetterTopicId,
+		MaxConcurrency:              int(request.Subscription.MaxConcurrency),
+		RateLimit:                   int(request.Subscription.RateLimit),
+		RateLimitBurst:              int(request.Subscription.RateLimitBurst),
+		OrderingEnabled:             request.Subscription.OrderingEnabled,
+		OrderingFailurePolicy:       request.Subscription.OrderingFailurePolicy,
+		SuccessStatusCodes:          request.Subscription.SuccessStatusCodes,
+		RedirectPolicy:              request.Subscription.RedirectPolicy,
+		PermanentFailureStatusCodes: request.Subscription.PermanentFailureStatusCodes,
+		PauseOnGone:                 request.Subscription.PauseOnGone,
+		Headers:                     request.Subscription.Headers,
+		HTTPMethod:                  request.Subscription.HttpMethod,
+		PayloadFormat:               request.Subscription.PayloadFormat,
+		TransformTemplate:           request.Subscription.TransformTemplate,
+		TransformContentType:        request.Subscription.TransformContentType,
 	}
 
 	// Validate subscription
@@ -102,7 +169,16 @@ func (s *SubscriptionHandler) UpdateSubscription(ctx context.Context, request *p
 	// Update subscription
 	err = s.subscriptionService.Update(&subscription)
 	if err != nil {
-		return &pb.Subscription{}, status.Error(codes.Internal, err.Error())
+		if _, ok := err.(validation.Errors); ok {
+			st := validationStatusError(codes.InvalidArgument, "invalid_subscription", err)
+			return &pb.Subscription{}, st.Err()
+		}
+		switch err {
+		case hammer.ErrDeadLetterTopicDoesNotExists, hammer.ErrDeadLetterTopicCycle:
+			return &pb.Subscription{}, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return &pb.Subscription{}, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return s.buildResponse(&subscription)
@@ -158,6 +234,73 @@ func (s *SubscriptionHandler) ListSubscriptions(ctx context.Context, request *pb
 	return response, nil
 }
 
+// PauseSubscription pause the subscription
+func (s *SubscriptionHandler) PauseSubscription(ctx context.Context, request *pb.PauseSubscriptionRequest) (*pb.Subscription, error) {
+	subscription, err := s.subscriptionService.Pause(request.Id)
+	if err != nil {
+		switch err {
+		case hammer.ErrSubscriptionDoesNotExists:
+			return &pb.Subscription{}, status.Error(codes.NotFound, err.Error())
+		default:
+			return &pb.Subscription{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return s.buildResponse(&subscription)
+}
+
+// ResumeSubscription resume the subscription
+func (s *SubscriptionHandler) ResumeSubscription(ctx context.Context, request *pb.ResumeSubscriptionRequest) (*pb.Subscription, error) {
+	subscription, err := s.subscriptionService.Resume(request.Id)
+	if err != nil {
+		switch err {
+		case hammer.ErrSubscriptionDoesNotExists:
+			return &pb.Subscription{}, status.Error(codes.NotFound, err.Error())
+		default:
+			return &pb.Subscription{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return s.buildResponse(&subscription)
+}
+
+// PreviewSubscriptionTransform renders the subscription transform template with a sample message
+func (s *SubscriptionHandler) PreviewSubscriptionTransform(ctx context.Context, request *pb.PreviewSubscriptionTransformRequest) (*pb.Message, error) {
+	if request.Subscription == nil {
+		request.Subscription = &pb.Subscription{}
+	}
+	if request.Message == nil {
+		request.Message = &pb.Message{}
+	}
+
+	subscription := hammer.Subscription{
+		ID:                   request.Subscription.Id,
+		TransformTemplate:    request.Subscription.TransformTemplate,
+		TransformContentType: request.Subscription.TransformContentType,
+	}
+	message := hammer.Message{
+		ID:          request.Message.Id,
+		TopicID:     request.Message.TopicId,
+		ContentType: request.Message.ContentType,
+		Data:        request.Message.Data,
+		Attributes:  request.Message.Attributes,
+	}
+
+	// Render transform template
+	preview, err := s.subscriptionService.PreviewTransform(&subscription, &message)
+	if err != nil {
+		return &pb.Message{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Message{
+		Id:          preview.ID,
+		TopicId:     preview.TopicID,
+		ContentType: preview.ContentType,
+		Data:        preview.Data,
+		Attributes:  preview.Attributes,
+	}, nil
+}
+
 // DeleteSubscription delete the subscription
 func (s *SubscriptionHandler) DeleteSubscription(ctx context.Context, request *pb.DeleteSubscriptionRequest) (*empty.Empty, error) {
 	response := &empty.Empty{}
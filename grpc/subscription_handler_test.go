@@ -6,10 +6,14 @@ import (
 	"github.com/allisson/hammer"
 	pb "github.com/allisson/hammer/api/v1"
 	"github.com/allisson/hammer/mocks"
+	"github.com/allisson/hammer/transform"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestSubscriptionHandler(t *testing.T) {
@@ -36,6 +40,131 @@ func TestSubscriptionHandler(t *testing.T) {
 		assert.Equal(t, "Subscription", response.Name)
 	})
 
+	t.Run("Test CreateSubscription with invalid retry policy", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.CreateSubscriptionRequest{
+			Subscription: &pb.Subscription{
+				Id:                     "subscription_id",
+				TopicId:                "topic_id",
+				Name:                   "Subscription",
+				Url:                    "https://example.com/post",
+				MaxDeliveryAttempts:    5,
+				DeliveryAttemptDelay:   60,
+				DeliveryAttemptTimeout: 5,
+				RetryPolicy:            "random",
+				RetryMaxDelay:          30,
+			},
+		}
+
+		_, err := handler.CreateSubscription(ctx, request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, 1, len(st.Details()))
+		fieldViolations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+		assert.Equal(t, 2, len(fieldViolations))
+	})
+
+	t.Run("Test CreateSubscription with invalid filter expression", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.CreateSubscriptionRequest{
+			Subscription: &pb.Subscription{
+				Id:                     "subscription_id",
+				TopicId:                "topic_id",
+				Name:                   "Subscription",
+				Url:                    "https://example.com/post",
+				MaxDeliveryAttempts:    5,
+				DeliveryAttemptDelay:   60,
+				DeliveryAttemptTimeout: 5,
+				FilterExpression:       `data.type = `,
+			},
+		}
+
+		_, err := handler.CreateSubscription(ctx, request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		fieldViolations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+		assert.Equal(t, 1, len(fieldViolations))
+		assert.Equal(t, "filter_expression", fieldViolations[0].Field)
+	})
+
+	t.Run("Test CreateSubscription with cloudevents header", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.CreateSubscriptionRequest{
+			Subscription: &pb.Subscription{
+				Id:                     "subscription_id",
+				TopicId:                "topic_id",
+				Name:                   "Subscription",
+				Url:                    "https://example.com/post",
+				MaxDeliveryAttempts:    5,
+				DeliveryAttemptDelay:   60,
+				DeliveryAttemptTimeout: 5,
+				Headers:                map[string]string{"Ce-Type": "other.type"},
+			},
+		}
+
+		_, err := handler.CreateSubscription(ctx, request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		fieldViolations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+		assert.Equal(t, 1, len(fieldViolations))
+		assert.Equal(t, "headers", fieldViolations[0].Field)
+	})
+
+	t.Run("Test CreateSubscription with dead-letter topic equal to topic", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.CreateSubscriptionRequest{
+			Subscription: &pb.Subscription{
+				Id:                     "subscription_id",
+				TopicId:                "topic_id",
+				Name:                   "Subscription",
+				Url:                    "https://example.com/post",
+				MaxDeliveryAttempts:    5,
+				DeliveryAttemptDelay:   60,
+				DeliveryAttemptTimeout: 5,
+				DeadLetterTopicId:      "topic_id",
+			},
+		}
+
+		_, err := handler.CreateSubscription(ctx, request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		fieldViolations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+		assert.Equal(t, 1, len(fieldViolations))
+		assert.Equal(t, "dead_letter_topic_id", fieldViolations[0].Field)
+	})
+
+	t.Run("Test CreateSubscription with dead-letter topic does not exists", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.CreateSubscriptionRequest{
+			Subscription: &pb.Subscription{
+				Id:                     "subscription_id",
+				TopicId:                "topic_id",
+				Name:                   "Subscription",
+				Url:                    "https://example.com/post",
+				MaxDeliveryAttempts:    5,
+				DeliveryAttemptDelay:   60,
+				DeliveryAttemptTimeout: 5,
+				DeadLetterTopicId:      "dead_letter_topic_id",
+			},
+		}
+		subscriptionService.On("Create", mock.Anything).Return(hammer.ErrDeadLetterTopicDoesNotExists)
+
+		_, err := handler.CreateSubscription(ctx, request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, hammer.ErrDeadLetterTopicDoesNotExists.Error(), st.Message())
+	})
+
 	t.Run("Test UpdateSubscription", func(t *testing.T) {
 		subscriptionService := &mocks.SubscriptionService{}
 		handler := NewSubscriptionHandler(subscriptionService)
@@ -64,8 +193,9 @@ func TestSubscriptionHandler(t *testing.T) {
 		handler := NewSubscriptionHandler(subscriptionService)
 		ctx := context.Background()
 		subscription := hammer.Subscription{
-			ID:   "subscription_id",
-			Name: "Subscription",
+			ID:      "subscription_id",
+			Name:    "Subscription",
+			Headers: hammer.StringMap{"Authorization": "Bearer secret", "X-Tenant-Id": "tenant"},
 		}
 		request := &pb.GetSubscriptionRequest{
 			Id: "subscription_id",
@@ -76,6 +206,7 @@ func TestSubscriptionHandler(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "subscription_id", response.Id)
 		assert.Equal(t, "Subscription", response.Name)
+		assert.Equal(t, map[string]string{"Authorization": "[REDACTED]", "X-Tenant-Id": "tenant"}, response.Headers)
 	})
 
 	t.Run("Test ListSubscriptions", func(t *testing.T) {
@@ -116,4 +247,72 @@ func TestSubscriptionHandler(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, &empty.Empty{}, response)
 	})
+
+	t.Run("Test PauseSubscription", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		subscription := hammer.Subscription{
+			ID:     "subscription_id",
+			Name:   "Subscription",
+			Paused: true,
+		}
+		request := &pb.PauseSubscriptionRequest{
+			Id: subscription.ID,
+		}
+		subscriptionService.On("Pause", subscription.ID).Return(subscription, nil)
+
+		response, err := handler.PauseSubscription(ctx, request)
+		assert.Nil(t, err)
+		assert.True(t, response.Paused)
+	})
+
+	t.Run("Test ResumeSubscription", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.ResumeSubscriptionRequest{
+			Id: "subscription_id",
+		}
+		subscriptionService.On("Resume", request.Id).Return(hammer.Subscription{}, hammer.ErrSubscriptionDoesNotExists)
+
+		_, err := handler.ResumeSubscription(ctx, request)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Test PreviewSubscriptionTransform", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.PreviewSubscriptionTransformRequest{
+			Subscription: &pb.Subscription{
+				TransformTemplate:    `{"text": {{json .data.name}}}`,
+				TransformContentType: "application/json",
+			},
+			Message: &pb.Message{
+				ContentType: "application/json",
+				Data:        `{"name": "Allisson"}`,
+			},
+		}
+		preview := hammer.Message{ContentType: "application/json", Data: `{"text": "Allisson"}`}
+		subscriptionService.On("PreviewTransform", mock.Anything, mock.Anything).Return(preview, nil)
+
+		response, err := handler.PreviewSubscriptionTransform(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, preview.Data, response.Data)
+		assert.Equal(t, preview.ContentType, response.ContentType)
+	})
+
+	t.Run("Test PreviewSubscriptionTransform with invalid template", func(t *testing.T) {
+		subscriptionService := &mocks.SubscriptionService{}
+		handler := NewSubscriptionHandler(subscriptionService)
+		ctx := context.Background()
+		request := &pb.PreviewSubscriptionTransformRequest{
+			Subscription: &pb.Subscription{TransformTemplate: `{{.data`},
+		}
+		subscriptionService.On("PreviewTransform", mock.Anything, mock.Anything).Return(hammer.Message{}, transform.ErrInvalidTemplate)
+
+		_, err := handler.PreviewSubscriptionTransform(ctx, request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
@@ -29,6 +29,9 @@ func (t *TopicHandler) buildResponse(topic *hammer.Topic) (*pb.Topic, error) {
 	}
 	response.Id = topic.ID
 	response.Name = topic.Name
+	response.EventType = topic.EventType
+	response.EventSource = topic.EventSource
+	response.SchemaVersion = uint32(topic.SchemaVersion)
 	response.CreatedAt = createdAt
 	response.UpdatedAt = updatedAt
 
@@ -43,8 +46,10 @@ func (t *TopicHandler) CreateTopic(ctx context.Context, request *pb.CreateTopicR
 
 	// Build a topic
 	topic := hammer.Topic{
-		ID:   request.Topic.Id,
-		Name: request.Topic.Name,
+		ID:          request.Topic.Id,
+		Name:        request.Topic.Name,
+		EventType:   request.Topic.EventType,
+		EventSource: request.Topic.EventSource,
 	}
 
 	// Validate topic
@@ -71,8 +76,10 @@ func (t *TopicHandler) UpdateTopic(ctx context.Context, request *pb.UpdateTopicR
 
 	// Build a topic
 	topic := hammer.Topic{
-		ID:   request.Topic.Id,
-		Name: request.Topic.Name,
+		ID:          request.Topic.Id,
+		Name:        request.Topic.Name,
+		EventType:   request.Topic.EventType,
+		EventSource: request.Topic.EventSource,
 	}
 
 	// Validate topic
@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/allisson/hammer"
+	pb "github.com/allisson/hammer/api/v1"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TopicSchemaHandler implements methods for topic schema get/update
+type TopicSchemaHandler struct {
+	topicSchemaService hammer.TopicSchemaService
+}
+
+func (t *TopicSchemaHandler) buildResponse(topicSchema *hammer.TopicSchema) (*pb.TopicSchema, error) {
+	response := &pb.TopicSchema{}
+	createdAt, err := ptypes.TimestampProto(topicSchema.CreatedAt)
+	if err != nil {
+		return response, status.Error(codes.Internal, err.Error())
+	}
+	response.TopicId = topicSchema.TopicID
+	response.Version = uint32(topicSchema.Version)
+	response.Schema = topicSchema.Schema
+	response.CreatedAt = createdAt
+
+	return response, nil
+}
+
+// GetTopicSchema gets the topic schema
+func (t *TopicSchemaHandler) GetTopicSchema(ctx context.Context, request *pb.GetTopicSchemaRequest) (*pb.TopicSchema, error) {
+	// Get topic schema from service
+	topicSchema, err := t.topicSchemaService.Find(request.TopicId, int(request.Version))
+	if err != nil {
+		switch err {
+		case hammer.ErrTopicDoesNotExists, hammer.ErrTopicSchemaDoesNotExists:
+			return &pb.TopicSchema{}, status.Error(codes.NotFound, err.Error())
+		default:
+			return &pb.TopicSchema{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return t.buildResponse(&topicSchema)
+}
+
+// UpdateTopicSchema creates a new version of the topic schema
+func (t *TopicSchemaHandler) UpdateTopicSchema(ctx context.Context, request *pb.UpdateTopicSchemaRequest) (*pb.TopicSchema, error) {
+	if request.TopicSchema == nil {
+		request.TopicSchema = &pb.TopicSchema{}
+	}
+
+	// Build a topic schema
+	topicSchema := hammer.TopicSchema{
+		TopicID: request.TopicSchema.TopicId,
+		Schema:  request.TopicSchema.Schema,
+	}
+
+	// Validate topic schema
+	err := topicSchema.Validate()
+	if err != nil {
+		st := validationStatusError(codes.InvalidArgument, "invalid_topic_schema", err)
+		return &pb.TopicSchema{}, st.Err()
+	}
+
+	// Update topic schema
+	err = t.topicSchemaService.Update(&topicSchema)
+	if err != nil {
+		switch err {
+		case hammer.ErrTopicDoesNotExists:
+			return &pb.TopicSchema{}, status.Error(codes.NotFound, err.Error())
+		default:
+			return &pb.TopicSchema{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return t.buildResponse(&topicSchema)
+}
+
+// NewTopicSchemaHandler returns a new TopicSchemaHandler
+func NewTopicSchemaHandler(topicSchemaService hammer.TopicSchemaService) TopicSchemaHandler {
+	return TopicSchemaHandler{topicSchemaService: topicSchemaService}
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/allisson/hammer"
+	pb "github.com/allisson/hammer/api/v1"
+	"github.com/allisson/hammer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTopicSchemaHandler(t *testing.T) {
+	t.Run("Test GetTopicSchema", func(t *testing.T) {
+		topicSchemaService := &mocks.TopicSchemaService{}
+		handler := NewTopicSchemaHandler(topicSchemaService)
+		ctx := context.Background()
+		topicSchema := hammer.TopicSchema{
+			TopicID:   "topic_id",
+			Version:   2,
+			Schema:    `{"type": "object"}`,
+			CreatedAt: time.Now().UTC(),
+		}
+		request := &pb.GetTopicSchemaRequest{
+			TopicId: "topic_id",
+		}
+		topicSchemaService.On("Find", "topic_id", 0).Return(topicSchema, nil)
+
+		response, err := handler.GetTopicSchema(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, "topic_id", response.TopicId)
+		assert.Equal(t, uint32(2), response.Version)
+		assert.Equal(t, `{"type": "object"}`, response.Schema)
+	})
+
+	t.Run("Test GetTopicSchema with topic schema does not exists", func(t *testing.T) {
+		topicSchemaService := &mocks.TopicSchemaService{}
+		handler := NewTopicSchemaHandler(topicSchemaService)
+		ctx := context.Background()
+		request := &pb.GetTopicSchemaRequest{
+			TopicId: "topic_id",
+			Version: 3,
+		}
+		topicSchemaService.On("Find", "topic_id", 3).Return(hammer.TopicSchema{}, hammer.ErrTopicSchemaDoesNotExists)
+
+		_, err := handler.GetTopicSchema(ctx, request)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Test UpdateTopicSchema", func(t *testing.T) {
+		topicSchemaService := &mocks.TopicSchemaService{}
+		handler := NewTopicSchemaHandler(topicSchemaService)
+		ctx := context.Background()
+		request := &pb.UpdateTopicSchemaRequest{
+			TopicSchema: &pb.TopicSchema{
+				TopicId: "topic_id",
+				Schema:  `{"type": "object"}`,
+			},
+		}
+		topicSchemaService.On("Update", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(0).(*hammer.TopicSchema).Version = 1
+		})
+
+		response, err := handler.UpdateTopicSchema(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, "topic_id", response.TopicId)
+		assert.Equal(t, uint32(1), response.Version)
+	})
+
+	t.Run("Test UpdateTopicSchema with invalid schema", func(t *testing.T) {
+		topicSchemaService := &mocks.TopicSchemaService{}
+		handler := NewTopicSchemaHandler(topicSchemaService)
+		ctx := context.Background()
+		request := &pb.UpdateTopicSchemaRequest{
+			TopicSchema: &pb.TopicSchema{
+				TopicId: "topic_id",
+				Schema:  `{"type": "invalid"}`,
+			},
+		}
+
+		_, err := handler.UpdateTopicSchema(ctx, request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		topicSchemaService.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("Test UpdateTopicSchema with topic does not exists", func(t *testing.T) {
+		topicSchemaService := &mocks.TopicSchemaService{}
+		handler := NewTopicSchemaHandler(topicSchemaService)
+		ctx := context.Background()
+		request := &pb.UpdateTopicSchemaRequest{
+			TopicSchema: &pb.TopicSchema{
+				TopicId: "topic_id",
+				Schema:  `{"type": "object"}`,
+			},
+		}
+		topicSchemaService.On("Update", mock.Anything).Return(hammer.ErrTopicDoesNotExists)
+
+		_, err := handler.UpdateTopicSchema(ctx, request)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	hammer "github.com/allisson/hammer"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // DeliveryRepository is an autogenerated mock type for the DeliveryRepository type
@@ -12,6 +14,85 @@ type DeliveryRepository struct {
 	mock.Mock
 }
 
+// Cancel provides a mock function with given fields: tx, id
+func (_m *DeliveryRepository) Cancel(tx hammer.TxRepository, id string) (hammer.Delivery, error) {
+	ret := _m.Called(tx, id)
+
+	var r0 hammer.Delivery
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) hammer.Delivery); ok {
+		r0 = rf(tx, id)
+	} else {
+		r0 = ret.Get(0).(hammer.Delivery)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(hammer.TxRepository, string) error); ok {
+		r1 = rf(tx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelByMessage provides a mock function with given fields: tx, messageID
+func (_m *DeliveryRepository) CancelByMessage(tx hammer.TxRepository, messageID string) (int, error) {
+	ret := _m.Called(tx, messageID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) int); ok {
+		r0 = rf(tx, messageID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(hammer.TxRepository, string) error); ok {
+		r1 = rf(tx, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Claim provides a mock function with given fields: owner, limit, leaseDuration, circuitBreakerOpenTimeout
+func (_m *DeliveryRepository) Claim(owner string, limit int, leaseDuration time.Duration, circuitBreakerOpenTimeout time.Duration) ([]hammer.Delivery, error) {
+	ret := _m.Called(owner, limit, leaseDuration, circuitBreakerOpenTimeout)
+
+	var r0 []hammer.Delivery
+	if rf, ok := ret.Get(0).(func(string, int, time.Duration, time.Duration) []hammer.Delivery); ok {
+		r0 = rf(owner, limit, leaseDuration, circuitBreakerOpenTimeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]hammer.Delivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, time.Duration, time.Duration) error); ok {
+		r1 = rf(owner, limit, leaseDuration, circuitBreakerOpenTimeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBatch provides a mock function with given fields: tx, deliveries
+func (_m *DeliveryRepository) CreateBatch(tx hammer.TxRepository, deliveries []hammer.Delivery) error {
+	ret := _m.Called(tx, deliveries)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, []hammer.Delivery) error); ok {
+		r0 = rf(tx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Find provides a mock function with given fields: id
 func (_m *DeliveryRepository) Find(id string) (hammer.Delivery, error) {
 	ret := _m.Called(id)
@@ -56,22 +137,55 @@ func (_m *DeliveryRepository) FindAll(findOptions hammer.FindOptions) ([]hammer.
 	return r0, r1
 }
 
-// FindToDispatch provides a mock function with given fields: limit, offset
-func (_m *DeliveryRepository) FindToDispatch(limit int, offset int) ([]string, error) {
-	ret := _m.Called(limit, offset)
+// FindForUpdate provides a mock function with given fields: tx, id
+func (_m *DeliveryRepository) FindForUpdate(tx hammer.TxRepository, id string) (hammer.Delivery, error) {
+	ret := _m.Called(tx, id)
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(int, int) []string); ok {
-		r0 = rf(limit, offset)
+	var r0 hammer.Delivery
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) hammer.Delivery); ok {
+		r0 = rf(tx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(hammer.Delivery)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(hammer.TxRepository, string) error); ok {
+		r1 = rf(tx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Notify provides a mock function with given fields: tx
+func (_m *DeliveryRepository) Notify(tx hammer.TxRepository) error {
+	ret := _m.Called(tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository) error); ok {
+		r0 = rf(tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Redeliver provides a mock function with given fields: tx, id, extraAttempts
+func (_m *DeliveryRepository) Redeliver(tx hammer.TxRepository, id string, extraAttempts int) (hammer.Delivery, error) {
+	ret := _m.Called(tx, id, extraAttempts)
+
+	var r0 hammer.Delivery
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string, int) hammer.Delivery); ok {
+		r0 = rf(tx, id, extraAttempts)
+	} else {
+		r0 = ret.Get(0).(hammer.Delivery)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(limit, offset)
+	if rf, ok := ret.Get(1).(func(hammer.TxRepository, string, int) error); ok {
+		r1 = rf(tx, id, extraAttempts)
 	} else {
 		r1 = ret.Error(1)
 	}
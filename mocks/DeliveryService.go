@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	http "net/http"
 
 	hammer "github.com/allisson/hammer"
@@ -15,20 +17,85 @@ type DeliveryService struct {
 	mock.Mock
 }
 
-// Dispatch provides a mock function with given fields: delivery, httpClient
-func (_m *DeliveryService) Dispatch(delivery *hammer.Delivery, httpClient *http.Client) (hammer.DeliveryAttempt, error) {
-	ret := _m.Called(delivery, httpClient)
+// Cancel provides a mock function with given fields: id
+func (_m *DeliveryService) Cancel(id string) (hammer.Delivery, error) {
+	ret := _m.Called(id)
+
+	var r0 hammer.Delivery
+	if rf, ok := ret.Get(0).(func(string) hammer.Delivery); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(hammer.Delivery)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelByMessage provides a mock function with given fields: messageID
+func (_m *DeliveryService) CancelByMessage(messageID string) (int, error) {
+	ret := _m.Called(messageID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(messageID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Claim provides a mock function with given fields: owner, limit
+func (_m *DeliveryService) Claim(owner string, limit int) ([]hammer.Delivery, error) {
+	ret := _m.Called(owner, limit)
+
+	var r0 []hammer.Delivery
+	if rf, ok := ret.Get(0).(func(string, int) []hammer.Delivery); ok {
+		r0 = rf(owner, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]hammer.Delivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(owner, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Dispatch provides a mock function with given fields: ctx, delivery, httpClient
+func (_m *DeliveryService) Dispatch(ctx context.Context, delivery *hammer.Delivery, httpClient *http.Client) (hammer.DeliveryAttempt, error) {
+	ret := _m.Called(ctx, delivery, httpClient)
 
 	var r0 hammer.DeliveryAttempt
-	if rf, ok := ret.Get(0).(func(*hammer.Delivery, *http.Client) hammer.DeliveryAttempt); ok {
-		r0 = rf(delivery, httpClient)
+	if rf, ok := ret.Get(0).(func(context.Context, *hammer.Delivery, *http.Client) hammer.DeliveryAttempt); ok {
+		r0 = rf(ctx, delivery, httpClient)
 	} else {
 		r0 = ret.Get(0).(hammer.DeliveryAttempt)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*hammer.Delivery, *http.Client) error); ok {
-		r1 = rf(delivery, httpClient)
+	if rf, ok := ret.Get(1).(func(context.Context, *hammer.Delivery, *http.Client) error); ok {
+		r1 = rf(ctx, delivery, httpClient)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -80,22 +147,41 @@ func (_m *DeliveryService) FindAll(findOptions hammer.FindOptions) ([]hammer.Del
 	return r0, r1
 }
 
-// FindToDispatch provides a mock function with given fields: limit, offset
-func (_m *DeliveryService) FindToDispatch(limit int, offset int) ([]string, error) {
-	ret := _m.Called(limit, offset)
+// Redeliver provides a mock function with given fields: id, extraAttempts
+func (_m *DeliveryService) Redeliver(id string, extraAttempts int) (hammer.Delivery, error) {
+	ret := _m.Called(id, extraAttempts)
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(int, int) []string); ok {
-		r0 = rf(limit, offset)
+	var r0 hammer.Delivery
+	if rf, ok := ret.Get(0).(func(string, int) hammer.Delivery); ok {
+		r0 = rf(id, extraAttempts)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(hammer.Delivery)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(id, extraAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedeliverAll provides a mock function with given fields: findOptions, extraAttempts
+func (_m *DeliveryService) RedeliverAll(findOptions hammer.FindOptions, extraAttempts int) (int, error) {
+	ret := _m.Called(findOptions, extraAttempts)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(hammer.FindOptions, int) int); ok {
+		r0 = rf(findOptions, extraAttempts)
+	} else {
+		r0 = ret.Get(0).(int)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(limit, offset)
+	if rf, ok := ret.Get(1).(func(hammer.FindOptions, int) error); ok {
+		r1 = rf(findOptions, extraAttempts)
 	} else {
 		r1 = ret.Error(1)
 	}
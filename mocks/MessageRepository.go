@@ -12,6 +12,20 @@ type MessageRepository struct {
 	mock.Mock
 }
 
+// CreateBatch provides a mock function with given fields: tx, messages
+func (_m *MessageRepository) CreateBatch(tx hammer.TxRepository, messages []hammer.Message) error {
+	ret := _m.Called(tx, messages)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, []hammer.Message) error); ok {
+		r0 = rf(tx, messages)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: tx, id
 func (_m *MessageRepository) Delete(tx hammer.TxRepository, id string) error {
 	ret := _m.Called(tx, id)
@@ -26,6 +26,29 @@ func (_m *MessageService) Create(message *hammer.Message) error {
 	return r0
 }
 
+// CreateBatch provides a mock function with given fields: messages
+func (_m *MessageService) CreateBatch(messages []*hammer.Message) ([]error, error) {
+	ret := _m.Called(messages)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func([]*hammer.Message) []error); ok {
+		r0 = rf(messages)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*hammer.Message) error); ok {
+		r1 = rf(messages)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: id
 func (_m *MessageService) Delete(id string) error {
 	ret := _m.Called(id)
@@ -70,6 +70,57 @@ func (_m *SubscriptionRepository) FindAll(findOptions hammer.FindOptions) ([]ham
 	return r0, r1
 }
 
+// FindByTopic provides a mock function with given fields: tx, topicID
+func (_m *SubscriptionRepository) FindByTopic(tx hammer.TxRepository, topicID string) ([]hammer.Subscription, error) {
+	ret := _m.Called(tx, topicID)
+
+	var r0 []hammer.Subscription
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) []hammer.Subscription); ok {
+		r0 = rf(tx, topicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]hammer.Subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(hammer.TxRepository, string) error); ok {
+		r1 = rf(tx, topicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pause provides a mock function with given fields: tx, id
+func (_m *SubscriptionRepository) Pause(tx hammer.TxRepository, id string) error {
+	ret := _m.Called(tx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) error); ok {
+		r0 = rf(tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resume provides a mock function with given fields: tx, id
+func (_m *SubscriptionRepository) Resume(tx hammer.TxRepository, id string) error {
+	ret := _m.Called(tx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) error); ok {
+		r0 = rf(tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Store provides a mock function with given fields: tx, subscription
 func (_m *SubscriptionRepository) Store(tx hammer.TxRepository, subscription *hammer.Subscription) error {
 	ret := _m.Called(tx, subscription)
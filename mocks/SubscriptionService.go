@@ -84,6 +84,69 @@ func (_m *SubscriptionService) FindAll(findOptions hammer.FindOptions) ([]hammer
 	return r0, r1
 }
 
+// Pause provides a mock function with given fields: id
+func (_m *SubscriptionService) Pause(id string) (hammer.Subscription, error) {
+	ret := _m.Called(id)
+
+	var r0 hammer.Subscription
+	if rf, ok := ret.Get(0).(func(string) hammer.Subscription); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(hammer.Subscription)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewTransform provides a mock function with given fields: subscription, message
+func (_m *SubscriptionService) PreviewTransform(subscription *hammer.Subscription, message *hammer.Message) (hammer.Message, error) {
+	ret := _m.Called(subscription, message)
+
+	var r0 hammer.Message
+	if rf, ok := ret.Get(0).(func(*hammer.Subscription, *hammer.Message) hammer.Message); ok {
+		r0 = rf(subscription, message)
+	} else {
+		r0 = ret.Get(0).(hammer.Message)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*hammer.Subscription, *hammer.Message) error); ok {
+		r1 = rf(subscription, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Resume provides a mock function with given fields: id
+func (_m *SubscriptionService) Resume(id string) (hammer.Subscription, error) {
+	ret := _m.Called(id)
+
+	var r0 hammer.Subscription
+	if rf, ok := ret.Get(0).(func(string) hammer.Subscription); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(hammer.Subscription)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: subscription
 func (_m *SubscriptionService) Update(subscription *hammer.Subscription) error {
 	ret := _m.Called(subscription)
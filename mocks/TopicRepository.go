@@ -70,6 +70,27 @@ func (_m *TopicRepository) FindAll(findOptions hammer.FindOptions) ([]hammer.Top
 	return r0, r1
 }
 
+// FindForShare provides a mock function with given fields: tx, id
+func (_m *TopicRepository) FindForShare(tx hammer.TxRepository, id string) (hammer.Topic, error) {
+	ret := _m.Called(tx, id)
+
+	var r0 hammer.Topic
+	if rf, ok := ret.Get(0).(func(hammer.TxRepository, string) hammer.Topic); ok {
+		r0 = rf(tx, id)
+	} else {
+		r0 = ret.Get(0).(hammer.Topic)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(hammer.TxRepository, string) error); ok {
+		r1 = rf(tx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Store provides a mock function with given fields: tx, topic
 func (_m *TopicRepository) Store(tx hammer.TxRepository, topic *hammer.Topic) error {
 	ret := _m.Called(tx, topic)
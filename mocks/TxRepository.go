@@ -37,6 +37,20 @@ func (_m *TxRepository) Exec(query string, arg interface{}) error {
 	return r0
 }
 
+// Get provides a mock function with given fields: dest, query, arg
+func (_m *TxRepository) Get(dest interface{}, query string, arg interface{}) error {
+	ret := _m.Called(dest, query, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, string, interface{}) error); ok {
+		r0 = rf(dest, query, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Rollback provides a mock function with given fields:
 func (_m *TxRepository) Rollback() error {
 	ret := _m.Called()
@@ -50,3 +64,45 @@ func (_m *TxRepository) Rollback() error {
 
 	return r0
 }
+
+// RollbackToSavepoint provides a mock function with given fields: name
+func (_m *TxRepository) RollbackToSavepoint(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Savepoint provides a mock function with given fields: name
+func (_m *TxRepository) Savepoint(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Select provides a mock function with given fields: dest, query, arg
+func (_m *TxRepository) Select(dest interface{}, query string, arg interface{}) error {
+	ret := _m.Called(dest, query, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, string, interface{}) error); ok {
+		r0 = rf(dest, query, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
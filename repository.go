@@ -1,18 +1,30 @@
 package hammer
 
+import "time"
+
 // TopicRepository interface
 type TopicRepository interface {
 	Find(id string) (Topic, error)
+	FindForShare(tx TxRepository, id string) (Topic, error)
 	FindAll(findOptions FindOptions) ([]Topic, error)
 	Store(tx TxRepository, topic *Topic) error
 	Delete(tx TxRepository, id string) error
 }
 
+// TopicSchemaRepository interface
+type TopicSchemaRepository interface {
+	Find(topicID string, version int) (TopicSchema, error)
+	Store(tx TxRepository, topicSchema *TopicSchema) error
+}
+
 // SubscriptionRepository interface
 type SubscriptionRepository interface {
 	Find(id string) (Subscription, error)
 	FindAll(findOptions FindOptions) ([]Subscription, error)
+	FindByTopic(tx TxRepository, topicID string) ([]Subscription, error)
 	Store(tx TxRepository, subscription *Subscription) error
+	Pause(tx TxRepository, id string) error
+	Resume(tx TxRepository, id string) error
 	Delete(tx TxRepository, id string) error
 }
 
@@ -21,6 +33,7 @@ type MessageRepository interface {
 	Find(id string) (Message, error)
 	FindAll(findOptions FindOptions) ([]Message, error)
 	Store(tx TxRepository, message *Message) error
+	CreateBatch(tx TxRepository, messages []Message) error
 	Delete(tx TxRepository, id string) error
 }
 
@@ -28,8 +41,14 @@ type MessageRepository interface {
 type DeliveryRepository interface {
 	Find(id string) (Delivery, error)
 	FindAll(findOptions FindOptions) ([]Delivery, error)
-	FindToDispatch(limit, offset int) ([]string, error)
+	FindForUpdate(tx TxRepository, id string) (Delivery, error)
+	Claim(owner string, limit int, leaseDuration, circuitBreakerOpenTimeout time.Duration) ([]Delivery, error)
 	Store(tx TxRepository, delivery *Delivery) error
+	Redeliver(tx TxRepository, id string, extraAttempts int) (Delivery, error)
+	Cancel(tx TxRepository, id string) (Delivery, error)
+	CancelByMessage(tx TxRepository, messageID string) (int, error)
+	CreateBatch(tx TxRepository, deliveries []Delivery) error
+	Notify(tx TxRepository) error
 }
 
 // DeliveryAttemptRepository interface
@@ -39,9 +58,28 @@ type DeliveryAttemptRepository interface {
 	Store(tx TxRepository, deliveryAttempt *DeliveryAttempt) error
 }
 
+// CircuitBreakerRepository interface
+type CircuitBreakerRepository interface {
+	Find(subscriptionID string) (CircuitBreaker, error)
+	FindAll(findOptions FindOptions) ([]CircuitBreaker, error)
+	CountByState() (map[string]int, error)
+	RecordSuccess(tx TxRepository, subscriptionID string) error
+	RecordFailure(tx TxRepository, subscriptionID string, threshold int) error
+}
+
+// NotificationRepository interface
+type NotificationRepository interface {
+	Notifications() <-chan struct{}
+	Close() error
+}
+
 // TxRepository interface
 type TxRepository interface {
 	Exec(query string, arg interface{}) error
+	Get(dest interface{}, query string, arg interface{}) error
+	Select(dest interface{}, query string, arg interface{}) error
+	Savepoint(name string) error
+	RollbackToSavepoint(name string) error
 	Commit() error
 	Rollback() error
 }
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/allisson/hammer"
+	"github.com/jmoiron/sqlx"
+)
+
+// CircuitBreaker is a implementation of hammer.CircuitBreakerRepository
+type CircuitBreaker struct {
+	db *sqlx.DB
+}
+
+// Find returns hammer.CircuitBreaker by subscription id
+func (c *CircuitBreaker) Find(subscriptionID string) (hammer.CircuitBreaker, error) {
+	circuitBreaker := hammer.CircuitBreaker{}
+	findOptions := hammer.FindOptions{
+		FindFilters: []hammer.FindFilter{
+			{
+				FieldName: "subscription_id",
+				Operator:  "=",
+				Value:     subscriptionID,
+			},
+		},
+	}
+	sql, args := buildSQLQuery("circuit_breakers", findOptions)
+	err := c.db.Get(&circuitBreaker, sql, args...)
+	return circuitBreaker, err
+}
+
+// FindAll returns []hammer.CircuitBreaker by limit and offset
+func (c *CircuitBreaker) FindAll(findOptions hammer.FindOptions) ([]hammer.CircuitBreaker, error) {
+	circuitBreakers := []hammer.CircuitBreaker{}
+	sql, args := buildSQLQuery("circuit_breakers", findOptions)
+	err := c.db.Select(&circuitBreakers, sql, args...)
+	return circuitBreakers, err
+}
+
+// CountByState returns the number of circuit breakers on each state
+func (c *CircuitBreaker) CountByState() (map[string]int, error) {
+	rows := []struct {
+		State string `db:"state"`
+		Count int    `db:"count"`
+	}{}
+	counts := make(map[string]int)
+	err := c.db.Select(&rows, sqlCircuitBreakerCountByState)
+	if err != nil {
+		return counts, err
+	}
+	for _, row := range rows {
+		counts[row.State] = row.Count
+	}
+	return counts, nil
+}
+
+// RecordSuccess closes the circuit breaker of the subscription
+func (c *CircuitBreaker) RecordSuccess(tx hammer.TxRepository, subscriptionID string) error {
+	args := map[string]interface{}{
+		"subscription_id": subscriptionID,
+		"updated_at":      time.Now().UTC(),
+	}
+	return tx.Exec(sqlCircuitBreakerRecordSuccess, args)
+}
+
+// RecordFailure increments the consecutive failures of the subscription, the circuit breaker opens
+// when the consecutive failures reach threshold or the half-open probe fails
+func (c *CircuitBreaker) RecordFailure(tx hammer.TxRepository, subscriptionID string, threshold int) error {
+	args := map[string]interface{}{
+		"subscription_id": subscriptionID,
+		"threshold":       threshold,
+		"updated_at":      time.Now().UTC(),
+	}
+	return tx.Exec(sqlCircuitBreakerRecordFailure, args)
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker with db connection
+func NewCircuitBreaker(db *sqlx.DB) CircuitBreaker {
+	return CircuitBreaker{db: db}
+}
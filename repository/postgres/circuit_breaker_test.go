@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/allisson/hammer"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("Test RecordFailure and RecordSuccess", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.circuitBreakerRepo.RecordFailure(tx, subscription.ID, 2)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		circuitBreaker, err := th.circuitBreakerRepo.Find(subscription.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.CircuitBreakerStateClosed, circuitBreaker.State)
+		assert.Equal(t, 1, circuitBreaker.ConsecutiveFailures)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		err = th.circuitBreakerRepo.RecordFailure(tx, subscription.ID, 2)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		circuitBreaker, err = th.circuitBreakerRepo.Find(subscription.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.CircuitBreakerStateOpen, circuitBreaker.State)
+		assert.Equal(t, 2, circuitBreaker.ConsecutiveFailures)
+		counts, err := th.circuitBreakerRepo.CountByState()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, counts[hammer.CircuitBreakerStateOpen])
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		err = th.circuitBreakerRepo.RecordSuccess(tx, subscription.ID)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		circuitBreaker, err = th.circuitBreakerRepo.Find(subscription.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.CircuitBreakerStateClosed, circuitBreaker.State)
+		assert.Equal(t, 0, circuitBreaker.ConsecutiveFailures)
+	})
+
+	t.Run("Test Claim with open circuit breaker", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			delivery := hammer.MakeTestDelivery()
+			delivery.TopicID = topic.ID
+			delivery.SubscriptionID = subscription.ID
+			delivery.MessageID = message.ID
+			err = th.deliveryRepo.Store(tx, &delivery)
+			assert.Nil(t, err)
+		}
+		err = th.circuitBreakerRepo.RecordFailure(tx, subscription.ID, 1)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		// The open circuit breaker defers the deliveries
+		deliveries, err := th.deliveryRepo.Claim("worker-1", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(deliveries))
+
+		// After the open timeout a single probe is claimed
+		deliveries, err = th.deliveryRepo.Claim("worker-1", 50, time.Duration(60)*time.Second, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(deliveries))
+		circuitBreaker, err := th.circuitBreakerRepo.Find(subscription.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.CircuitBreakerStateHalfOpen, circuitBreaker.State)
+		deliveries, err = th.deliveryRepo.Claim("worker-2", 50, time.Duration(60)*time.Second, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(deliveries))
+	})
+}
@@ -38,13 +38,48 @@ func (d *Delivery) FindAll(findOptions hammer.FindOptions) ([]hammer.Delivery, e
 	return deliveries, err
 }
 
-// FindToDispatch returns []hammer.Delivery ready to dispatch by limit and offset
-func (d *Delivery) FindToDispatch(limit, offset int) ([]string, error) {
-	deliveries := []string{}
-	status := "pending"
+// FindForUpdate returns hammer.Delivery by id and locks it until tx ends
+func (d *Delivery) FindForUpdate(tx hammer.TxRepository, id string) (hammer.Delivery, error) {
+	delivery := hammer.Delivery{}
+	err := tx.Get(&delivery, sqlDeliveryFindForUpdate, map[string]interface{}{"id": id})
+	return delivery, err
+}
+
+// Claim leases up to limit deliveries ready to dispatch to owner and returns them,
+// rows locked by another transaction are skipped and deliveries with an expired lease are claimed again.
+// Deliveries of a subscription with an open circuit breaker are skipped, after circuitBreakerOpenTimeout
+// a single delivery is claimed as the half-open probe.
+// On subscriptions with ordering enabled, a delivery is skipped while an earlier delivery with the same
+// ordering key is pending, or failed when the ordering failure policy is block.
+// The subscriptions with max concurrency are locked before the claim, so concurrent claims of the same
+// subscription are serialized and the leased deliveries count is not stale.
+func (d *Delivery) Claim(owner string, limit int, leaseDuration, circuitBreakerOpenTimeout time.Duration) ([]hammer.Delivery, error) {
+	deliveries := []hammer.Delivery{}
 	now := time.Now().UTC()
-	err := d.db.Select(&deliveries, sqlDeliveryFindToDispatch, status, now, limit, offset)
-	return deliveries, err
+	tx, err := d.db.Beginx()
+	if err != nil {
+		return deliveries, err
+	}
+	_, err = tx.Exec(sqlDeliveryClaimLock, now, hammer.DeliveryStatusPending)
+	if err != nil {
+		_ = tx.Rollback()
+		return deliveries, err
+	}
+	err = tx.Select(&deliveries, sqlDeliveryClaim, owner, now, int(leaseDuration.Seconds()), hammer.DeliveryStatusPending, limit, int(circuitBreakerOpenTimeout.Seconds()), hammer.DeliveryStatusFailed)
+	if err != nil {
+		_ = tx.Rollback()
+		return []hammer.Delivery{}, err
+	}
+	return deliveries, tx.Commit()
+}
+
+// CreateBatch creates the deliveries on database with multi-row inserts
+func (d *Delivery) CreateBatch(tx hammer.TxRepository, deliveries []hammer.Delivery) error {
+	args := make([]interface{}, len(deliveries))
+	for i := range deliveries {
+		args[i] = &deliveries[i]
+	}
+	return batchInsert(tx, d.db.Mapper, sqlDeliveryCreate, args)
 }
 
 // Store a hammer.Delivery on database (create or update)
@@ -59,6 +94,54 @@ func (d *Delivery) Store(tx hammer.TxRepository, delivery *hammer.Delivery) erro
 	return tx.Exec(sqlDeliveryUpdate, delivery)
 }
 
+// Redeliver changes the delivery status to pending when it is completed or failed and not leased by a worker and returns it,
+// sql.ErrNoRows is returned when the delivery does not exists or it can't be redelivered
+func (d *Delivery) Redeliver(tx hammer.TxRepository, id string, extraAttempts int) (hammer.Delivery, error) {
+	delivery := hammer.Delivery{}
+	arg := map[string]interface{}{
+		"id":             id,
+		"extra_attempts": extraAttempts,
+		"pending":        hammer.DeliveryStatusPending,
+		"completed":      hammer.DeliveryStatusCompleted,
+		"failed":         hammer.DeliveryStatusFailed,
+		"updated_at":     time.Now().UTC(),
+	}
+	err := tx.Get(&delivery, sqlDeliveryRedeliver, arg)
+	return delivery, err
+}
+
+// Cancel changes the delivery status to cancelled when it is pending and returns it,
+// sql.ErrNoRows is returned when the delivery does not exists or it is not pending
+func (d *Delivery) Cancel(tx hammer.TxRepository, id string) (hammer.Delivery, error) {
+	delivery := hammer.Delivery{}
+	arg := map[string]interface{}{
+		"id":         id,
+		"cancelled":  hammer.DeliveryStatusCancelled,
+		"pending":    hammer.DeliveryStatusPending,
+		"updated_at": time.Now().UTC(),
+	}
+	err := tx.Get(&delivery, sqlDeliveryCancel, arg)
+	return delivery, err
+}
+
+// CancelByMessage changes the status of the pending deliveries of the message to cancelled and returns how many were cancelled
+func (d *Delivery) CancelByMessage(tx hammer.TxRepository, messageID string) (int, error) {
+	var count int
+	arg := map[string]interface{}{
+		"message_id": messageID,
+		"cancelled":  hammer.DeliveryStatusCancelled,
+		"pending":    hammer.DeliveryStatusPending,
+		"updated_at": time.Now().UTC(),
+	}
+	err := tx.Get(&count, sqlDeliveryCancelByMessage, arg)
+	return count, err
+}
+
+// Notify wakes up the workers listening for new deliveries, the notification is sent when tx commits
+func (d *Delivery) Notify(tx hammer.TxRepository) error {
+	return tx.Exec(sqlDeliveryNotify, map[string]interface{}{})
+}
+
 // NewDelivery returns a new Delivery with db connection
 func NewDelivery(db *sqlx.DB) Delivery {
 	return Delivery{db: db}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"database/sql"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -37,6 +40,38 @@ func TestDelivery(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test CreateBatch", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		deliveries := []hammer.Delivery{hammer.MakeTestDelivery(), hammer.MakeTestDelivery()}
+		for i := range deliveries {
+			deliveries[i].TopicID = topic.ID
+			deliveries[i].SubscriptionID = subscription.ID
+			deliveries[i].MessageID = message.ID
+		}
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		err = th.deliveryRepo.CreateBatch(tx, deliveries)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		deliveryFromRepo, err := th.deliveryRepo.Find(deliveries[1].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, deliveries[1].Status, deliveryFromRepo.Status)
+	})
+
 	t.Run("Test Store against created Delivery", func(t *testing.T) {
 		th := newTxnTestHelper()
 		defer th.db.Close()
@@ -148,7 +183,101 @@ func TestDelivery(t *testing.T) {
 		assert.Equal(t, 2, len(deliveries))
 	})
 
-	t.Run("Test FindToDispatch", func(t *testing.T) {
+	t.Run("Test Redeliver", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		deliveries := []hammer.Delivery{hammer.MakeTestDelivery(), hammer.MakeTestDelivery()}
+		for i := range deliveries {
+			deliveries[i].TopicID = topic.ID
+			deliveries[i].SubscriptionID = subscription.ID
+			deliveries[i].MessageID = message.ID
+		}
+		deliveries[0].Status = hammer.DeliveryStatusFailed
+		deliveries[1].LeaseOwner = "worker"
+		deliveries[1].LeaseExpiresAt = time.Now().UTC().Add(time.Minute)
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		err = th.deliveryRepo.CreateBatch(tx, deliveries)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		delivery, err := th.deliveryRepo.Redeliver(tx, deliveries[0].ID, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
+		assert.Equal(t, deliveries[0].MaxDeliveryAttempts+2, delivery.MaxDeliveryAttempts)
+		_, err = th.deliveryRepo.Redeliver(tx, deliveries[1].ID, 2)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Cancel", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		deliveries := []hammer.Delivery{hammer.MakeTestDelivery(), hammer.MakeTestDelivery()}
+		for i := range deliveries {
+			deliveries[i].TopicID = topic.ID
+			deliveries[i].SubscriptionID = subscription.ID
+			deliveries[i].MessageID = message.ID
+		}
+		deliveries[1].Status = hammer.DeliveryStatusCompleted
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		err = th.deliveryRepo.CreateBatch(tx, deliveries)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		delivery, err := th.deliveryRepo.Cancel(tx, deliveries[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusCancelled, delivery.Status)
+		_, err = th.deliveryRepo.Cancel(tx, deliveries[1].ID)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = tx.Rollback()
+		assert.Nil(t, err)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		count, err := th.deliveryRepo.CancelByMessage(tx, message.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		deliveryFromRepo, err := th.deliveryRepo.Find(deliveries[1].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusCompleted, deliveryFromRepo.Status)
+	})
+
+	t.Run("Test Claim", func(t *testing.T) {
 		th := newTxnTestHelper()
 		defer th.db.Close()
 
@@ -173,10 +302,36 @@ func TestDelivery(t *testing.T) {
 		delivery3.SubscriptionID = subscription.ID
 		delivery3.MessageID = message.ID
 		delivery3.Status = hammer.DeliveryStatusFailed
+		delivery4 := hammer.MakeTestDelivery()
+		delivery4.TopicID = topic.ID
+		delivery4.SubscriptionID = subscription.ID
+		delivery4.MessageID = message.ID
+		delivery4.Status = hammer.DeliveryStatusCancelled
+		pausedSubscription := hammer.MakeTestSubscription()
+		pausedSubscription.TopicID = topic.ID
+		pausedSubscription.Paused = true
+		delivery5 := hammer.MakeTestDelivery()
+		delivery5.TopicID = topic.ID
+		delivery5.SubscriptionID = pausedSubscription.ID
+		delivery5.MessageID = message.ID
+		delivery6 := hammer.MakeTestDelivery()
+		delivery6.TopicID = topic.ID
+		delivery6.SubscriptionID = subscription.ID
+		delivery6.MessageID = message.ID
+		delivery6.LeaseOwner = "worker-1"
+		delivery6.LeaseExpiresAt = time.Now().Add(time.Duration(1) * time.Hour)
+		delivery7 := hammer.MakeTestDelivery()
+		delivery7.TopicID = topic.ID
+		delivery7.SubscriptionID = subscription.ID
+		delivery7.MessageID = message.ID
+		delivery7.LeaseOwner = "worker-1"
+		delivery7.LeaseExpiresAt = time.Now().Add(time.Duration(-1) * time.Hour)
 		err = th.topicRepo.Store(tx, &topic)
 		assert.Nil(t, err)
 		err = th.subscriptionRepo.Store(tx, &subscription)
 		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &pausedSubscription)
+		assert.Nil(t, err)
 		err = th.messageRepo.Store(tx, &message)
 		assert.Nil(t, err)
 		err = th.deliveryRepo.Store(tx, &delivery1)
@@ -185,11 +340,290 @@ func TestDelivery(t *testing.T) {
 		assert.Nil(t, err)
 		err = th.deliveryRepo.Store(tx, &delivery3)
 		assert.Nil(t, err)
+		err = th.deliveryRepo.Store(tx, &delivery4)
+		assert.Nil(t, err)
+		err = th.deliveryRepo.Store(tx, &delivery5)
+		assert.Nil(t, err)
+		err = th.deliveryRepo.Store(tx, &delivery6)
+		assert.Nil(t, err)
+		err = th.deliveryRepo.Store(tx, &delivery7)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		deliveries, err := th.deliveryRepo.Claim("worker-2", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(deliveries))
+		for _, delivery := range deliveries {
+			assert.Contains(t, []string{delivery1.ID, delivery7.ID}, delivery.ID)
+			assert.Equal(t, "worker-2", delivery.LeaseOwner)
+			assert.True(t, delivery.LeaseExpiresAt.After(time.Now()))
+		}
+		deliveries, err = th.deliveryRepo.Claim("worker-3", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(deliveries))
+	})
+
+	t.Run("Test Claim with subscription max concurrency", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.MaxConcurrency = 2
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			delivery := hammer.MakeTestDelivery()
+			delivery.TopicID = topic.ID
+			delivery.SubscriptionID = subscription.ID
+			delivery.MessageID = message.ID
+			err = th.deliveryRepo.Store(tx, &delivery)
+			assert.Nil(t, err)
+		}
+		err = tx.Commit()
+		assert.Nil(t, err)
+		deliveries, err := th.deliveryRepo.Claim("worker-1", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(deliveries))
+		deliveries, err = th.deliveryRepo.Claim("worker-2", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(deliveries))
+	})
+	t.Run("Test Claim with saturated subscription", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		saturatedSubscription := hammer.MakeTestSubscription()
+		saturatedSubscription.TopicID = topic.ID
+		saturatedSubscription.MaxConcurrency = 1
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &saturatedSubscription)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		for i, subscriptionID := range []string{saturatedSubscription.ID, subscription.ID} {
+			for j := 0; j < 3; j++ {
+				delivery := hammer.MakeTestDelivery()
+				delivery.ID = fmt.Sprintf("Delivery_%d_%d", i, j)
+				delivery.TopicID = topic.ID
+				delivery.SubscriptionID = subscriptionID
+				delivery.MessageID = message.ID
+				err = th.deliveryRepo.Store(tx, &delivery)
+				assert.Nil(t, err)
+			}
+		}
 		err = tx.Commit()
 		assert.Nil(t, err)
-		deliveries, err := th.deliveryRepo.FindToDispatch(50, 0)
+		deliveries, err := th.deliveryRepo.Claim("worker-1", 1, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(deliveries))
-		assert.Equal(t, delivery1.ID, deliveries[0])
+		assert.Equal(t, saturatedSubscription.ID, deliveries[0].SubscriptionID)
+		deliveries, err = th.deliveryRepo.Claim("worker-2", 3, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(deliveries))
+		for _, delivery := range deliveries {
+			assert.Equal(t, subscription.ID, delivery.SubscriptionID)
+		}
+	})
+	t.Run("Test concurrent Claim with subscription max concurrency", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.MaxConcurrency = 2
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		for i := 0; i < 10; i++ {
+			delivery := hammer.MakeTestDelivery()
+			delivery.TopicID = topic.ID
+			delivery.SubscriptionID = subscription.ID
+			delivery.MessageID = message.ID
+			err = th.deliveryRepo.Store(tx, &delivery)
+			assert.Nil(t, err)
+		}
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		var wg sync.WaitGroup
+		claimed := make(chan int, 5)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(owner string) {
+				defer wg.Done()
+				deliveries, err := th.deliveryRepo.Claim(owner, 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+				assert.Nil(t, err)
+				claimed <- len(deliveries)
+			}(fmt.Sprintf("worker-%d", i))
+		}
+		wg.Wait()
+		close(claimed)
+		total := 0
+		for n := range claimed {
+			total += n
+		}
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("Test Claim with subscription rate limit", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.RateLimit = 2
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			delivery := hammer.MakeTestDelivery()
+			delivery.TopicID = topic.ID
+			delivery.SubscriptionID = subscription.ID
+			delivery.MessageID = message.ID
+			err = th.deliveryRepo.Store(tx, &delivery)
+			assert.Nil(t, err)
+		}
+		err = tx.Commit()
+		assert.Nil(t, err)
+		deliveries, err := th.deliveryRepo.Claim("worker-1", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(deliveries))
+		deliveries, err = th.deliveryRepo.Claim("worker-2", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(deliveries))
+	})
+	t.Run("Test Claim with throttled subscription", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		throttledSubscription := hammer.MakeTestSubscription()
+		throttledSubscription.TopicID = topic.ID
+		throttledSubscription.RateLimit = 1
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &throttledSubscription)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		for i, subscriptionID := range []string{throttledSubscription.ID, subscription.ID} {
+			for j := 0; j < 3; j++ {
+				delivery := hammer.MakeTestDelivery()
+				delivery.ID = fmt.Sprintf("Delivery_%d_%d", i, j)
+				delivery.TopicID = topic.ID
+				delivery.SubscriptionID = subscriptionID
+				delivery.MessageID = message.ID
+				err = th.deliveryRepo.Store(tx, &delivery)
+				assert.Nil(t, err)
+			}
+		}
+		err = tx.Commit()
+		assert.Nil(t, err)
+		deliveries, err := th.deliveryRepo.Claim("worker-1", 1, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(deliveries))
+		assert.Equal(t, throttledSubscription.ID, deliveries[0].SubscriptionID)
+		deliveries, err = th.deliveryRepo.Claim("worker-2", 3, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(deliveries))
+		for _, delivery := range deliveries {
+			assert.Equal(t, subscription.ID, delivery.SubscriptionID)
+		}
+	})
+	t.Run("Test Claim with ordering key", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.OrderingEnabled = true
+		subscription.OrderingFailurePolicy = hammer.OrderingFailurePolicyBlock
+		message := hammer.MakeTestMessage()
+		message.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message)
+		assert.Nil(t, err)
+		deliveries := []hammer.Delivery{}
+		for i, orderingKey := range []string{"order-1", "order-1", "order-2"} {
+			delivery := hammer.MakeTestDelivery()
+			delivery.ID = fmt.Sprintf("Delivery_ordered_%d", i)
+			delivery.TopicID = topic.ID
+			delivery.SubscriptionID = subscription.ID
+			delivery.MessageID = message.ID
+			delivery.OrderingKey = orderingKey
+			err = th.deliveryRepo.Store(tx, &delivery)
+			assert.Nil(t, err)
+			deliveries = append(deliveries, delivery)
+		}
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		// Only the first delivery of each ordering key is claimed
+		claimed, err := th.deliveryRepo.Claim("worker-1", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(claimed))
+
+		// A failed delivery blocks the next delivery with the same ordering key
+		deliveries[0].Status = hammer.DeliveryStatusFailed
+		deliveries[0].LeaseExpiresAt = time.Now().UTC()
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		err = th.deliveryRepo.Store(tx, &deliveries[0])
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		claimed, err = th.deliveryRepo.Claim("worker-2", 50, time.Duration(60)*time.Second, time.Duration(60)*time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(claimed))
 	})
 }
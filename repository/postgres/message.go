@@ -5,8 +5,21 @@ import (
 
 	"github.com/allisson/hammer"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+const (
+	uniqueViolationCode        = "23505"
+	messageIdempotencyKeyIndex = "messages_topic_id_idempotency_key_idx"
+)
+
+func messageError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == uniqueViolationCode && pqErr.Constraint == messageIdempotencyKeyIndex {
+		return hammer.ErrMessageIdempotencyKeyConflict
+	}
+	return err
+}
+
 // Message is a implementation of hammer.MessageRepository
 type Message struct {
 	db *sqlx.DB
@@ -42,11 +55,20 @@ func (m *Message) Store(tx hammer.TxRepository, message *hammer.Message) error {
 	_, err := m.Find(message.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return tx.Exec(sqlMessageCreate, message)
+			return messageError(tx.Exec(sqlMessageCreate, message))
 		}
 		return err
 	}
-	return tx.Exec(sqlMessageUpdate, message)
+	return messageError(tx.Exec(sqlMessageUpdate, message))
+}
+
+// CreateBatch creates the messages on database with multi-row inserts
+func (m *Message) CreateBatch(tx hammer.TxRepository, messages []hammer.Message) error {
+	args := make([]interface{}, len(messages))
+	for i := range messages {
+		args[i] = &messages[i]
+	}
+	return messageError(batchInsert(tx, m.db.Mapper, sqlMessageCreate, args))
 }
 
 // Delete a hammer.Message on database
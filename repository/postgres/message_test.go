@@ -26,6 +26,53 @@ func TestMessage(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test CreateBatch", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		message1 := hammer.MakeTestMessage()
+		message1.TopicID = topic.ID
+		message2 := hammer.MakeTestMessage()
+		message2.TopicID = topic.ID
+		message2.Attributes = hammer.StringMap{"traceid": "trace"}
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.messageRepo.CreateBatch(tx, []hammer.Message{message1, message2})
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		messageFromRepo, err := th.messageRepo.Find(message2.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, message2.Data, messageFromRepo.Data)
+		assert.Equal(t, message2.Attributes, messageFromRepo.Attributes)
+	})
+
+	t.Run("Test Store with repeated idempotency key", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		message1 := hammer.MakeTestMessage()
+		message1.TopicID = topic.ID
+		message1.IdempotencyKey = "key"
+		message2 := hammer.MakeTestMessage()
+		message2.TopicID = topic.ID
+		message2.IdempotencyKey = "key"
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message1)
+		assert.Nil(t, err)
+		err = th.messageRepo.Store(tx, &message2)
+		assert.Equal(t, hammer.ErrMessageIdempotencyKeyConflict, err)
+		err = tx.Rollback()
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test Store against created Message", func(t *testing.T) {
 		th := newTxnTestHelper()
 		defer th.db.Close()
@@ -63,6 +110,7 @@ func TestMessage(t *testing.T) {
 		topic := hammer.MakeTestTopic()
 		message := hammer.MakeTestMessage()
 		message.TopicID = topic.ID
+		message.Attributes = hammer.StringMap{"traceid": "trace"}
 		err = th.topicRepo.Store(tx, &topic)
 		assert.Nil(t, err)
 		err = th.messageRepo.Store(tx, &message)
@@ -73,6 +121,7 @@ func TestMessage(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, messageFromRepo.ID, message.ID)
 		assert.Equal(t, messageFromRepo.Data, message.Data)
+		assert.Equal(t, messageFromRepo.Attributes, message.Attributes)
 	})
 
 	t.Run("Test FindAll", func(t *testing.T) {
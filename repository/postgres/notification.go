@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const deliveriesChannel = "hammer_deliveries"
+
+// Notification is a implementation of hammer.NotificationRepository
+type Notification struct {
+	listener      *pq.Listener
+	notifications chan struct{}
+	done          chan struct{}
+}
+
+// forward coalesces the database notifications, a nil notification is sent by pq.Listener after
+// a reconnect and is forwarded too because notifications may have been lost while disconnected
+func forward(listener *pq.Listener, notifications chan<- struct{}, done <-chan struct{}) {
+	for {
+		select {
+		case <-listener.Notify:
+			select {
+			case notifications <- struct{}{}:
+			default:
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// Notifications returns the channel that receives a value when new deliveries are ready to dispatch
+func (n *Notification) Notifications() <-chan struct{} {
+	return n.notifications
+}
+
+// Close stops listening for notifications
+func (n *Notification) Close() error {
+	close(n.done)
+	return n.listener.Close()
+}
+
+// NewNotification returns a new Notification listening for new deliveries on the database,
+// it returns an error when the first connection attempt fails
+func NewNotification(databaseURL string) (Notification, error) {
+	connected := make(chan error, 1)
+	var once sync.Once
+	listener := pq.NewListener(databaseURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		once.Do(func() { connected <- err })
+	})
+	if err := <-connected; err != nil {
+		listener.Close()
+		return Notification{}, err
+	}
+	if err := listener.Listen(deliveriesChannel); err != nil {
+		listener.Close()
+		return Notification{}, err
+	}
+	notification := Notification{
+		listener:      listener,
+		notifications: make(chan struct{}, 1),
+		done:          make(chan struct{}),
+	}
+	go forward(listener, notification.notifications, notification.done)
+	return notification, nil
+}
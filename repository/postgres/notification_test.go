@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/allisson/go-env"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification(t *testing.T) {
+	t.Run("Test Notifications after Notify commit", func(t *testing.T) {
+		databaseURL := env.GetString("HAMMER_DATABASE_URL", "")
+		notificationRepo, err := NewNotification(databaseURL)
+		assert.Nil(t, err)
+		defer notificationRepo.Close()
+
+		// The notification is sent on commit, so a real transaction is used instead of txdb
+		db, err := sqlx.Open("postgres", databaseURL)
+		assert.Nil(t, err)
+		defer db.Close()
+		deliveryRepo := NewDelivery(db)
+		txFactory := NewTxFactory(db)
+		tx, err := txFactory.New()
+		assert.Nil(t, err)
+		err = deliveryRepo.Notify(tx)
+		assert.Nil(t, err)
+		select {
+		case <-notificationRepo.Notifications():
+			t.Fatal("notification received before commit")
+		case <-time.After(100 * time.Millisecond):
+		}
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		select {
+		case <-notificationRepo.Notifications():
+		case <-time.After(time.Second):
+			t.Fatal("notification not received")
+		}
+	})
+}
@@ -1,11 +1,48 @@
 package repository
 
 import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
 	"github.com/allisson/hammer"
 	"github.com/huandu/go-sqlbuilder"
+	"github.com/jmoiron/sqlx/reflectx"
 )
 
+// batchInsertSize is the max number of rows on each multi-row insert
+const batchInsertSize = 500
+
+var namedParamRegex = regexp.MustCompile(`:(\w+)`)
+
 const (
+	// CircuitBreaker Statements
+	sqlCircuitBreakerCreate = `
+		INSERT INTO circuit_breakers (subscription_id)
+		VALUES (:id)
+		ON CONFLICT DO NOTHING
+	`
+	sqlCircuitBreakerCountByState = `
+		SELECT state, COUNT(*) AS count
+		FROM circuit_breakers
+		GROUP BY state
+	`
+	sqlCircuitBreakerRecordSuccess = `
+		UPDATE circuit_breakers
+		SET state = 'closed',
+			consecutive_failures = 0,
+			updated_at = :updated_at
+		WHERE subscription_id = :subscription_id AND (state <> 'closed' OR consecutive_failures > 0)
+	`
+	sqlCircuitBreakerRecordFailure = `
+		UPDATE circuit_breakers
+		SET consecutive_failures = consecutive_failures + 1,
+			state = CASE WHEN state = 'half_open' OR consecutive_failures + 1 >= :threshold THEN 'open' ELSE state END,
+			opened_at = CASE WHEN state = 'half_open' OR consecutive_failures + 1 >= :threshold THEN :updated_at ELSE opened_at END,
+			updated_at = :updated_at
+		WHERE subscription_id = :subscription_id
+	`
 	// DeliveryAttempt Statements
 	sqlDeliveryAttemptCreate = `
 		INSERT INTO delivery_attempts (
@@ -44,13 +81,125 @@ const (
 		WHERE id = :id
 	`
 	// Delivery Statements
-	sqlDeliveryFindToDispatch = `
-		SELECT id
-		FROM deliveries
-		WHERE status = $1 AND scheduled_at < $2
-		ORDER BY id ASC
-		LIMIT $3
-		OFFSET $4
+	sqlDeliveryClaimLock = `
+		SELECT subscriptions.id
+		FROM subscriptions
+		WHERE subscriptions.max_concurrency > 0 AND subscriptions.paused = FALSE
+		AND EXISTS (
+			SELECT 1 FROM deliveries ready
+			WHERE ready.subscription_id = subscriptions.id AND ready.status = $2 AND ready.scheduled_at < $1 AND ready.lease_expires_at < $1
+		)
+		ORDER BY subscriptions.id ASC
+		FOR NO KEY UPDATE OF subscriptions
+	`
+	sqlDeliveryClaim = `
+		WITH buckets AS (
+			SELECT subscription_rate_limits.subscription_id,
+				LEAST(
+					CASE WHEN subscriptions.rate_limit_burst = 0 THEN subscriptions.rate_limit ELSE subscriptions.rate_limit_burst END,
+					subscription_rate_limits.tokens + subscriptions.rate_limit * GREATEST(EXTRACT(EPOCH FROM $2::TIMESTAMPTZ - subscription_rate_limits.updated_at), 0)
+				) AS tokens
+			FROM subscription_rate_limits
+			INNER JOIN subscriptions ON subscriptions.id = subscription_rate_limits.subscription_id
+			WHERE subscriptions.rate_limit > 0 AND subscriptions.paused = FALSE
+			AND EXISTS (
+				SELECT 1 FROM deliveries ready
+				WHERE ready.subscription_id = subscriptions.id AND ready.status = $4 AND ready.scheduled_at < $2 AND ready.lease_expires_at < $2
+			)
+			FOR UPDATE OF subscription_rate_limits SKIP LOCKED
+		), probes AS (
+			SELECT circuit_breakers.subscription_id
+			FROM circuit_breakers
+			WHERE circuit_breakers.state <> 'closed' AND circuit_breakers.updated_at <= $2::TIMESTAMPTZ - $6::INT * INTERVAL '1 second'
+			AND NOT EXISTS (
+				SELECT 1 FROM deliveries leased
+				WHERE leased.subscription_id = circuit_breakers.subscription_id AND leased.status = $4 AND leased.lease_expires_at >= $2
+			)
+			FOR UPDATE SKIP LOCKED
+		), ready AS (
+			SELECT deliveries.id, subscriptions.max_concurrency, subscriptions.rate_limit, buckets.tokens,
+				probes.subscription_id IS NOT NULL AS probe,
+				ROW_NUMBER() OVER (PARTITION BY deliveries.subscription_id ORDER BY deliveries.id) AS position,
+				(
+					SELECT COUNT(*) FROM deliveries leased
+					WHERE leased.subscription_id = deliveries.subscription_id AND leased.status = $4 AND leased.lease_expires_at >= $2
+				) AS leased
+			FROM deliveries
+			INNER JOIN subscriptions ON subscriptions.id = deliveries.subscription_id
+			LEFT JOIN buckets ON buckets.subscription_id = deliveries.subscription_id
+			LEFT JOIN circuit_breakers ON circuit_breakers.subscription_id = deliveries.subscription_id
+			LEFT JOIN probes ON probes.subscription_id = deliveries.subscription_id
+			WHERE deliveries.status = $4 AND deliveries.scheduled_at < $2 AND deliveries.lease_expires_at < $2 AND subscriptions.paused = FALSE
+			AND (circuit_breakers.state IS NULL OR circuit_breakers.state = 'closed' OR probes.subscription_id IS NOT NULL)
+			AND (subscriptions.rate_limit = 0 OR buckets.tokens >= 1)
+			AND (subscriptions.ordering_enabled = FALSE OR deliveries.ordering_key = '' OR NOT EXISTS (
+				SELECT 1 FROM deliveries earlier
+				WHERE earlier.subscription_id = deliveries.subscription_id AND earlier.ordering_key = deliveries.ordering_key AND earlier.id < deliveries.id
+				AND (earlier.status = $4 OR (earlier.status = $7 AND subscriptions.ordering_failure_policy = 'block'))
+			))
+		), candidates AS (
+			SELECT deliveries.id
+			FROM deliveries
+			INNER JOIN ready ON ready.id = deliveries.id
+			WHERE (ready.max_concurrency = 0 OR ready.position + ready.leased <= ready.max_concurrency)
+			AND (ready.rate_limit = 0 OR ready.position <= FLOOR(ready.tokens))
+			AND (NOT ready.probe OR ready.position = 1)
+			ORDER BY deliveries.id ASC
+			LIMIT $5
+			FOR UPDATE OF deliveries SKIP LOCKED
+		), claimed AS (
+			UPDATE deliveries
+			SET lease_owner = $1,
+				lease_expires_at = $2::TIMESTAMPTZ + (deliveries.delivery_attempt_timeout + $3::INT) * INTERVAL '1 second'
+			FROM candidates
+			WHERE deliveries.id = candidates.id
+			RETURNING deliveries.*
+		), spent AS (
+			UPDATE subscription_rate_limits
+			SET tokens = buckets.tokens - (SELECT COUNT(*) FROM claimed WHERE claimed.subscription_id = buckets.subscription_id),
+				updated_at = $2
+			FROM buckets
+			WHERE subscription_rate_limits.subscription_id = buckets.subscription_id
+		), half_opened AS (
+			UPDATE circuit_breakers
+			SET state = 'half_open',
+				updated_at = $2
+			FROM probes
+			WHERE circuit_breakers.subscription_id = probes.subscription_id
+			AND EXISTS (SELECT 1 FROM claimed WHERE claimed.subscription_id = probes.subscription_id)
+		)
+		SELECT * FROM claimed
+	`
+	sqlDeliveryFindForUpdate = `
+		SELECT * FROM deliveries
+		WHERE id = :id
+		FOR UPDATE
+	`
+	sqlDeliveryRedeliver = `
+		UPDATE deliveries
+		SET status = :pending,
+			scheduled_at = :updated_at,
+			max_delivery_attempts = deliveries.max_delivery_attempts + :extra_attempts,
+			updated_at = :updated_at
+		WHERE id = :id AND status IN (:completed, :failed) AND lease_expires_at < :updated_at
+		RETURNING *
+	`
+	sqlDeliveryCancel = `
+		UPDATE deliveries
+		SET status = :cancelled,
+			updated_at = :updated_at
+		WHERE id = :id AND status = :pending
+		RETURNING *
+	`
+	sqlDeliveryCancelByMessage = `
+		WITH cancelled AS (
+			UPDATE deliveries
+			SET status = :cancelled,
+				updated_at = :updated_at
+			WHERE message_id = :message_id AND status = :pending
+			RETURNING id
+		)
+		SELECT COUNT(*) FROM cancelled
 	`
 	sqlDeliveryCreate = `
 		INSERT INTO deliveries (
@@ -62,12 +211,33 @@ const (
 			"data",
 			"url",
 			"secret_token",
+			"signing_mode",
 			"max_delivery_attempts",
 			"delivery_attempt_delay",
 			"delivery_attempt_timeout",
+			"retry_policy",
+			"retry_max_delay",
+			"retry_jitter",
+			"attributes",
+			"dead_letter_topic_id",
+			"ordering_key",
+			"success_status_codes",
+			"redirect_policy",
+			"permanent_failure_status_codes",
+			"pause_on_gone",
+			"headers",
+			"http_method",
+			"payload_format",
+			"transform_template",
+			"transform_content_type",
+			"event_type",
+			"event_source",
 			"scheduled_at",
 			"delivery_attempts",
+			"throttled_attempts",
 			"status",
+			"lease_owner",
+			"lease_expires_at",
 			"created_at",
 			"updated_at"
 		)
@@ -80,12 +250,33 @@ const (
 			:data,
 			:url,
 			:secret_token,
+			:signing_mode,
 			:max_delivery_attempts,
 			:delivery_attempt_delay,
 			:delivery_attempt_timeout,
+			:retry_policy,
+			:retry_max_delay,
+			:retry_jitter,
+			:attributes,
+			:dead_letter_topic_id,
+			:ordering_key,
+			:success_status_codes,
+			:redirect_policy,
+			:permanent_failure_status_codes,
+			:pause_on_gone,
+			:headers,
+			:http_method,
+			:payload_format,
+			:transform_template,
+			:transform_content_type,
+			:event_type,
+			:event_source,
 			:scheduled_at,
 			:delivery_attempts,
+			:throttled_attempts,
 			:status,
+			:lease_owner,
+			:lease_expires_at,
 			:created_at,
 			:updated_at
 		)
@@ -99,16 +290,38 @@ const (
 			data = :data,
 			url = :url,
 			secret_token = :secret_token,
+			signing_mode = :signing_mode,
 			max_delivery_attempts = :max_delivery_attempts,
 			delivery_attempt_delay = :delivery_attempt_delay,
 			delivery_attempt_timeout = :delivery_attempt_timeout,
+			retry_policy = :retry_policy,
+			retry_max_delay = :retry_max_delay,
+			retry_jitter = :retry_jitter,
+			attributes = :attributes,
+			dead_letter_topic_id = :dead_letter_topic_id,
+			ordering_key = :ordering_key,
+			success_status_codes = :success_status_codes,
+			redirect_policy = :redirect_policy,
+			permanent_failure_status_codes = :permanent_failure_status_codes,
+			pause_on_gone = :pause_on_gone,
+			headers = :headers,
+			http_method = :http_method,
+			payload_format = :payload_format,
+			transform_template = :transform_template,
+			transform_content_type = :transform_content_type,
+			event_type = :event_type,
+			event_source = :event_source,
 			scheduled_at = :scheduled_at,
 			delivery_attempts = :delivery_attempts,
+			throttled_attempts = :throttled_attempts,
 			status = :status,
+			lease_owner = :lease_owner,
+			lease_expires_at = :lease_expires_at,
 			created_at = :created_at,
 			updated_at = :updated_at
 		WHERE id = :id
 	`
+	sqlDeliveryNotify = `NOTIFY ` + deliveriesChannel
 	// Message Statements
 	sqlMessageCreate = `
 		INSERT INTO messages (
@@ -116,6 +329,11 @@ const (
 			"topic_id",
 			"content_type",
 			"data",
+			"attributes",
+			"idempotency_key",
+			"ordering_key",
+			"event_type",
+			"event_source",
 			"created_at"
 		)
 		VALUES (
@@ -123,6 +341,11 @@ const (
 			:topic_id,
 			:content_type,
 			:data,
+			:attributes,
+			:idempotency_key,
+			:ordering_key,
+			:event_type,
+			:event_source,
 			:created_at
 		)
 	`
@@ -131,6 +354,11 @@ const (
 		SET topic_id = :topic_id,
 			content_type = :content_type,
 			data = :data,
+			attributes = :attributes,
+			idempotency_key = :idempotency_key,
+			ordering_key = :ordering_key,
+			event_type = :event_type,
+			event_source = :event_source,
 			created_at = :created_at
 		WHERE id = :id
 	`
@@ -146,9 +374,30 @@ const (
 			"name",
 			"url",
 			"secret_token",
+			"signing_mode",
 			"max_delivery_attempts",
 			"delivery_attempt_delay",
 			"delivery_attempt_timeout",
+			"retry_policy",
+			"retry_max_delay",
+			"retry_jitter",
+			"paused",
+			"filter_expression",
+			"dead_letter_topic_id",
+			"max_concurrency",
+			"rate_limit",
+			"rate_limit_burst",
+			"ordering_enabled",
+			"ordering_failure_policy",
+			"success_status_codes",
+			"redirect_policy",
+			"permanent_failure_status_codes",
+			"pause_on_gone",
+			"headers",
+			"http_method",
+			"payload_format",
+			"transform_template",
+			"transform_content_type",
 			"created_at",
 			"updated_at"
 		)
@@ -158,9 +407,30 @@ const (
 			:name,
 			:url,
 			:secret_token,
+			:signing_mode,
 			:max_delivery_attempts,
 			:delivery_attempt_delay,
 			:delivery_attempt_timeout,
+			:retry_policy,
+			:retry_max_delay,
+			:retry_jitter,
+			:paused,
+			:filter_expression,
+			:dead_letter_topic_id,
+			:max_concurrency,
+			:rate_limit,
+			:rate_limit_burst,
+			:ordering_enabled,
+			:ordering_failure_policy,
+			:success_status_codes,
+			:redirect_policy,
+			:permanent_failure_status_codes,
+			:pause_on_gone,
+			:headers,
+			:http_method,
+			:payload_format,
+			:transform_template,
+			:transform_content_type,
 			:created_at,
 			:updated_at
 		)
@@ -171,13 +441,54 @@ const (
 			name = :name,
 			url = :url,
 			secret_token = :secret_token,
+			signing_mode = :signing_mode,
 			max_delivery_attempts = :max_delivery_attempts,
 			delivery_attempt_delay = :delivery_attempt_delay,
 			delivery_attempt_timeout = :delivery_attempt_timeout,
+			retry_policy = :retry_policy,
+			retry_max_delay = :retry_max_delay,
+			retry_jitter = :retry_jitter,
+			filter_expression = :filter_expression,
+			dead_letter_topic_id = :dead_letter_topic_id,
+			max_concurrency = :max_concurrency,
+			rate_limit = :rate_limit,
+			rate_limit_burst = :rate_limit_burst,
+			ordering_enabled = :ordering_enabled,
+			ordering_failure_policy = :ordering_failure_policy,
+			success_status_codes = :success_status_codes,
+			redirect_policy = :redirect_policy,
+			permanent_failure_status_codes = :permanent_failure_status_codes,
+			pause_on_gone = :pause_on_gone,
+			headers = :headers,
+			http_method = :http_method,
+			payload_format = :payload_format,
+			transform_template = :transform_template,
+			transform_content_type = :transform_content_type,
 			created_at = :created_at,
 			updated_at = :updated_at
 		WHERE id = :id
 	`
+	sqlSubscriptionRateLimitCreate = `
+		INSERT INTO subscription_rate_limits (subscription_id)
+		VALUES (:id)
+		ON CONFLICT DO NOTHING
+	`
+	sqlSubscriptionFindByTopic = `
+		SELECT * FROM subscriptions
+		WHERE topic_id = :topic_id
+	`
+	sqlSubscriptionPause = `
+		UPDATE subscriptions
+		SET paused = TRUE,
+			updated_at = :updated_at
+		WHERE id = :id AND paused = FALSE
+	`
+	sqlSubscriptionResume = `
+		UPDATE subscriptions
+		SET paused = FALSE,
+			updated_at = :updated_at
+		WHERE id = :id AND paused = TRUE
+	`
 	sqlSubscriptionDelete = `
 		DELETE FROM subscriptions
 		WHERE id = :id
@@ -187,12 +498,20 @@ const (
 		INSERT INTO topics (
 			"id",
 			"name",
+			"event_type",
+			"event_source",
+			"schema",
+			"schema_version",
 			"created_at",
 			"updated_at"
 		)
 		VALUES (
 			:id,
 			:name,
+			:event_type,
+			:event_source,
+			:schema,
+			:schema_version,
 			:created_at,
 			:updated_at
 		)
@@ -200,14 +519,41 @@ const (
 	sqlTopicUpdate = `
 		UPDATE topics
 		SET name = :name,
+			event_type = :event_type,
+			event_source = :event_source,
 			created_at = :created_at,
 			updated_at = :updated_at
 		WHERE id = :id
 	`
+	sqlTopicFindForShare = `
+		SELECT * FROM topics
+		WHERE id = :id
+		FOR KEY SHARE
+	`
 	sqlTopicDelete = `
 		DELETE FROM topics
 		WHERE id = :id
 	`
+	// TopicSchema Statements
+	sqlTopicSchemaCreate = `
+		WITH topic AS (
+			UPDATE topics
+			SET schema = :schema,
+				schema_version = topics.schema_version + 1,
+				updated_at = :created_at
+			WHERE id = :topic_id
+			RETURNING topics.id, topics.schema_version
+		)
+		INSERT INTO topic_schemas (
+			"topic_id",
+			"version",
+			"schema",
+			"created_at"
+		)
+		SELECT topic.id, topic.schema_version, :schema, :created_at
+		FROM topic
+		RETURNING version
+	`
 )
 
 func buildSQLQuery(tableName string, findOptions hammer.FindOptions) (sql string, args []interface{}) {
@@ -243,3 +589,46 @@ func buildSQLQuery(tableName string, findOptions hammer.FindOptions) (sql string
 	// Return result
 	return sb.Build()
 }
+
+// buildBatchInsert converts a named insert statement into a multi-row insert with one row for each arg
+func buildBatchInsert(mapper *reflectx.Mapper, insertSQL string, args []interface{}) (string, map[string]interface{}) {
+	i := strings.Index(insertSQL, "VALUES")
+	head, row := insertSQL[:i+len("VALUES")], insertSQL[i+len("VALUES"):]
+	names := namedParamRegex.FindAllStringSubmatch(row, -1)
+
+	var sb strings.Builder
+	sb.WriteString(head)
+	params := make(map[string]interface{}, len(names)*len(args))
+	for n, arg := range args {
+		v := reflect.Indirect(reflect.ValueOf(arg))
+		if n > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(" (")
+		for j, name := range names {
+			param := fmt.Sprintf("%s_%d", name[1], n)
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(":" + param)
+			params[param] = mapper.FieldByName(v, name[1]).Interface()
+		}
+		sb.WriteString(")")
+	}
+
+	return sb.String(), params
+}
+
+func batchInsert(tx hammer.TxRepository, mapper *reflectx.Mapper, insertSQL string, args []interface{}) error {
+	for start := 0; start < len(args); start += batchInsertSize {
+		end := start + batchInsertSize
+		if end > len(args) {
+			end = len(args)
+		}
+		query, params := buildBatchInsert(mapper, insertSQL, args[start:end])
+		if err := tx.Exec(query, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
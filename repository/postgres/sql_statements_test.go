@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/allisson/hammer"
+	"github.com/jmoiron/sqlx/reflectx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBatchInsert(t *testing.T) {
+	mapper := reflectx.NewMapperFunc("db", strings.ToLower)
+	topic1 := hammer.MakeTestTopic()
+	topic2 := hammer.MakeTestTopic()
+
+	query, params := buildBatchInsert(mapper, sqlTopicCreate, []interface{}{&topic1, &topic2})
+	assert.Contains(t, query, "INSERT INTO topics")
+	assert.Contains(t, query, "VALUES (:id_0, :name_0, :event_type_0, :event_source_0, :schema_0, :schema_version_0, :created_at_0, :updated_at_0), (:id_1, :name_1, :event_type_1, :event_source_1, :schema_1, :schema_version_1, :created_at_1, :updated_at_1)")
+	assert.Equal(t, 16, len(params))
+	assert.Equal(t, topic1.ID, params["id_0"])
+	assert.Equal(t, topic2.Name, params["name_1"])
+	assert.Equal(t, topic2.CreatedAt, params["created_at_1"])
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/allisson/hammer"
 	"github.com/jmoiron/sqlx"
@@ -37,18 +38,46 @@ func (s *Subscription) FindAll(findOptions hammer.FindOptions) ([]hammer.Subscri
 	return subscriptions, err
 }
 
+// FindByTopic returns []hammer.Subscription of the topic using tx
+func (s *Subscription) FindByTopic(tx hammer.TxRepository, topicID string) ([]hammer.Subscription, error) {
+	subscriptions := []hammer.Subscription{}
+	err := tx.Select(&subscriptions, sqlSubscriptionFindByTopic, map[string]interface{}{"topic_id": topicID})
+	return subscriptions, err
+}
+
 // Store a hammer.Subscription on database (create or update)
 func (s *Subscription) Store(tx hammer.TxRepository, subscription *hammer.Subscription) error {
 	_, err := s.Find(subscription.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return tx.Exec(sqlSubscriptionCreate, subscription)
+			if err := tx.Exec(sqlSubscriptionCreate, subscription); err != nil {
+				return err
+			}
+			if err := tx.Exec(sqlSubscriptionRateLimitCreate, subscription); err != nil {
+				return err
+			}
+			return tx.Exec(sqlCircuitBreakerCreate, subscription)
 		}
 		return err
 	}
 	return tx.Exec(sqlSubscriptionUpdate, subscription)
 }
 
+// Pause sets the subscription as paused without changing the other columns
+func (s *Subscription) Pause(tx hammer.TxRepository, id string) error {
+	return tx.Exec(sqlSubscriptionPause, map[string]interface{}{"id": id, "updated_at": time.Now().UTC()})
+}
+
+// Resume sets the subscription as not paused without changing the other columns,
+// the workers are notified to dispatch the pending deliveries when tx commits
+func (s *Subscription) Resume(tx hammer.TxRepository, id string) error {
+	err := tx.Exec(sqlSubscriptionResume, map[string]interface{}{"id": id, "updated_at": time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	return tx.Exec(sqlDeliveryNotify, map[string]interface{}{})
+}
+
 // Delete a hammer.Subscription on database
 func (s *Subscription) Delete(tx hammer.TxRepository, id string) error {
 	_, err := s.Find(id)
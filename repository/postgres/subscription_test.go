@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/allisson/hammer"
@@ -45,6 +46,8 @@ func TestSubscription(t *testing.T) {
 		tx, err = th.txFactory.New()
 		assert.Nil(t, err)
 		subscription.Name = "My Subscription III"
+		subscription.Headers = hammer.StringMap{"X-Tenant-Id": "tenant"}
+		subscription.HTTPMethod = http.MethodPut
 		err = th.subscriptionRepo.Store(tx, &subscription)
 		assert.Nil(t, err)
 		err = tx.Commit()
@@ -52,6 +55,8 @@ func TestSubscription(t *testing.T) {
 		subscriptionFromRepo, err := th.subscriptionRepo.Find(subscription.ID)
 		assert.Nil(t, err)
 		assert.Equal(t, subscription.Name, subscriptionFromRepo.Name)
+		assert.Equal(t, subscription.Headers, subscriptionFromRepo.Headers)
+		assert.Equal(t, http.MethodPut, subscriptionFromRepo.HTTPMethod)
 	})
 
 	t.Run("Test Find", func(t *testing.T) {
@@ -106,6 +111,65 @@ func TestSubscription(t *testing.T) {
 		assert.Equal(t, 2, len(subscriptions))
 	})
 
+	t.Run("Test FindByTopic", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		subscriptions, err := th.subscriptionRepo.FindByTopic(tx, topic.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(subscriptions))
+		assert.Equal(t, subscription.ID, subscriptions[0].ID)
+		err = tx.Commit()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Pause and Resume", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Store(tx, &subscription)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Pause(tx, subscription.ID)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		subscriptionFromRepo, err := th.subscriptionRepo.Find(subscription.ID)
+		assert.Nil(t, err)
+		assert.True(t, subscriptionFromRepo.Paused)
+		assert.Equal(t, subscription.Name, subscriptionFromRepo.Name)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		err = th.subscriptionRepo.Resume(tx, subscription.ID)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		subscriptionFromRepo, err = th.subscriptionRepo.Find(subscription.ID)
+		assert.Nil(t, err)
+		assert.False(t, subscriptionFromRepo.Paused)
+	})
+
 	t.Run("Test Delete", func(t *testing.T) {
 		th := newTxnTestHelper()
 		defer th.db.Close()
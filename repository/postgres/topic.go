@@ -29,6 +29,13 @@ func (t *Topic) Find(id string) (hammer.Topic, error) {
 	return topic, err
 }
 
+// FindForShare returns hammer.Topic by id and keeps it from being deleted until tx ends
+func (t *Topic) FindForShare(tx hammer.TxRepository, id string) (hammer.Topic, error) {
+	topic := hammer.Topic{}
+	err := tx.Get(&topic, sqlTopicFindForShare, map[string]interface{}{"id": id})
+	return topic, err
+}
+
 // FindAll returns []hammer.Topic by limit and offset
 func (t *Topic) FindAll(findOptions hammer.FindOptions) ([]hammer.Topic, error) {
 	topics := []hammer.Topic{}
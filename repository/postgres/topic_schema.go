@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"strconv"
+
+	"github.com/allisson/hammer"
+	"github.com/jmoiron/sqlx"
+)
+
+// TopicSchema is a implementation of hammer.TopicSchemaRepository
+type TopicSchema struct {
+	db *sqlx.DB
+}
+
+// Find returns hammer.TopicSchema by topic id and version
+func (t *TopicSchema) Find(topicID string, version int) (hammer.TopicSchema, error) {
+	topicSchema := hammer.TopicSchema{}
+	findOptions := hammer.FindOptions{
+		FindFilters: []hammer.FindFilter{
+			{
+				FieldName: "topic_id",
+				Operator:  "=",
+				Value:     topicID,
+			},
+			{
+				FieldName: "version",
+				Operator:  "=",
+				Value:     strconv.Itoa(version),
+			},
+		},
+	}
+	sql, args := buildSQLQuery("topic_schemas", findOptions)
+	err := t.db.Get(&topicSchema, sql, args...)
+	return topicSchema, err
+}
+
+// Store creates the next version of the topic schema on database and sets it as the topic schema,
+// the version is assigned with the topic row locked so concurrent updates get distinct versions.
+// sql.ErrNoRows is returned when the topic does not exists.
+func (t *TopicSchema) Store(tx hammer.TxRepository, topicSchema *hammer.TopicSchema) error {
+	return tx.Get(&topicSchema.Version, sqlTopicSchemaCreate, topicSchema)
+}
+
+// NewTopicSchema returns a new TopicSchema with db connection
+func NewTopicSchema(db *sqlx.DB) TopicSchema {
+	return TopicSchema{db: db}
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/allisson/hammer"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicSchema(t *testing.T) {
+	t.Run("Test Store and Find", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+		for _, value := range []string{`{"type": "object"}`, `{"type": "array"}`} {
+			tx, err = th.txFactory.New()
+			assert.Nil(t, err)
+			topicSchema := hammer.TopicSchema{TopicID: topic.ID, Schema: value, CreatedAt: time.Now().UTC()}
+			err = th.topicSchemaRepo.Store(tx, &topicSchema)
+			assert.Nil(t, err)
+			err = tx.Commit()
+			assert.Nil(t, err)
+		}
+		topic.Name = "My Topic"
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		topicSchemaFromRepo, err := th.topicSchemaRepo.Find(topic.ID, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"type": "object"}`, topicSchemaFromRepo.Schema)
+		topicFromRepo, err := th.topicRepo.Find(topic.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"type": "array"}`, topicFromRepo.Schema)
+		assert.Equal(t, 2, topicFromRepo.SchemaVersion)
+		_, err = th.topicSchemaRepo.Find(topic.ID, 3)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("Test Store with topic does not exists", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topicSchema := hammer.TopicSchema{TopicID: "topic", Schema: `{"type": "object"}`, CreatedAt: time.Now().UTC()}
+		err = th.topicSchemaRepo.Store(tx, &topicSchema)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = tx.Rollback()
+		assert.Nil(t, err)
+	})
+}
@@ -20,10 +20,12 @@ func init() {
 type txnTestHelper struct {
 	db                  *sqlx.DB
 	topicRepo           Topic
+	topicSchemaRepo     TopicSchema
 	subscriptionRepo    Subscription
 	messageRepo         Message
 	deliveryRepo        Delivery
 	deliveryAttemptRepo DeliveryAttempt
+	circuitBreakerRepo  CircuitBreaker
 	txFactory           TxFactory
 }
 
@@ -33,10 +35,12 @@ func newTxnTestHelper() txnTestHelper {
 	return txnTestHelper{
 		db:                  db,
 		topicRepo:           NewTopic(db),
+		topicSchemaRepo:     NewTopicSchema(db),
 		subscriptionRepo:    NewSubscription(db),
 		messageRepo:         NewMessage(db),
 		deliveryRepo:        NewDelivery(db),
 		deliveryAttemptRepo: NewDeliveryAttempt(db),
+		circuitBreakerRepo:  NewCircuitBreaker(db),
 		txFactory:           NewTxFactory(db),
 	}
 }
@@ -70,6 +74,8 @@ func TestTopic(t *testing.T) {
 		tx, err = th.txFactory.New()
 		assert.Nil(t, err)
 		topic.Name = "My Topic III"
+		topic.EventType = "com.example.order.created"
+		topic.EventSource = "https://example.com/orders"
 		err = th.topicRepo.Store(tx, &topic)
 		assert.Nil(t, err)
 		err = tx.Commit()
@@ -77,6 +83,8 @@ func TestTopic(t *testing.T) {
 		topicFromRepo, err := th.topicRepo.Find(topic.ID)
 		assert.Nil(t, err)
 		assert.Equal(t, topic.Name, topicFromRepo.Name)
+		assert.Equal(t, topic.EventType, topicFromRepo.EventType)
+		assert.Equal(t, topic.EventSource, topicFromRepo.EventSource)
 	})
 
 	t.Run("Test Find", func(t *testing.T) {
@@ -96,6 +104,27 @@ func TestTopic(t *testing.T) {
 		assert.Equal(t, topicFromRepo.Name, topic.Name)
 	})
 
+	t.Run("Test FindForShare", func(t *testing.T) {
+		th := newTxnTestHelper()
+		defer th.db.Close()
+
+		tx, err := th.txFactory.New()
+		assert.Nil(t, err)
+		topic := hammer.MakeTestTopic()
+		err = th.topicRepo.Store(tx, &topic)
+		assert.Nil(t, err)
+		err = tx.Commit()
+		assert.Nil(t, err)
+
+		tx, err = th.txFactory.New()
+		assert.Nil(t, err)
+		topicFromRepo, err := th.topicRepo.FindForShare(tx, topic.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, topic.ID, topicFromRepo.ID)
+		err = tx.Commit()
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test FindAll", func(t *testing.T) {
 		th := newTxnTestHelper()
 		defer th.db.Close()
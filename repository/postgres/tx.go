@@ -13,6 +13,36 @@ func (t *Tx) Exec(query string, arg interface{}) error {
 	return err
 }
 
+// Get executes a query that returns a single row and scans it into dest, sql.ErrNoRows is returned when there is no row
+func (t *Tx) Get(dest interface{}, query string, arg interface{}) error {
+	query, args, err := t.tx.BindNamed(query, arg)
+	if err != nil {
+		return err
+	}
+	return t.tx.Get(dest, query, args...)
+}
+
+// Select executes a query that returns rows and scans them into dest
+func (t *Tx) Select(dest interface{}, query string, arg interface{}) error {
+	query, args, err := t.tx.BindNamed(query, arg)
+	if err != nil {
+		return err
+	}
+	return t.tx.Select(dest, query, args...)
+}
+
+// Savepoint creates a savepoint with name on the transaction
+func (t *Tx) Savepoint(name string) error {
+	_, err := t.tx.Exec("SAVEPOINT " + name)
+	return err
+}
+
+// RollbackToSavepoint discards the changes made after the savepoint with name, the transaction can be used again
+func (t *Tx) RollbackToSavepoint(name string) error {
+	_, err := t.tx.Exec("ROLLBACK TO SAVEPOINT " + name)
+	return err
+}
+
 // Commit commits the transaction
 func (t *Tx) Commit() error {
 	return t.tx.Commit()
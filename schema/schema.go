@@ -0,0 +1,105 @@
+// Package schema implements the JSON Schema validation of the message data of a topic.
+//
+// The schemas are validated with the drafts supported by gojsonschema (draft-04, draft-06
+// and draft-07). Only local references ("#/definitions/...") are allowed, a schema can't
+// load other documents.
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+var (
+	// ErrInvalidSchema is used when the schema can't be parsed.
+	ErrInvalidSchema = errors.New("invalid_json_schema")
+	// ErrInvalidData is used when the data is not a json document.
+	ErrInvalidData = errors.New("invalid_json_data")
+)
+
+// Violation is a failed constraint of the schema
+type Violation struct {
+	// Field is the dotted path of the field on the data, empty for the root document
+	Field       string
+	Description string
+}
+
+// Schema is a parsed JSON Schema
+type Schema struct {
+	schema *gojsonschema.Schema
+}
+
+// Violations returns the schema constraints that data does not satisfy
+func (s *Schema) Violations(data []byte) ([]Violation, error) {
+	if !json.Valid(data) {
+		return nil, ErrInvalidData
+	}
+	result, err := s.schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, err
+	}
+	violations := []Violation{}
+	for _, e := range result.Errors() {
+		field := e.Field()
+		if field == gojsonschema.STRING_CONTEXT_ROOT {
+			field = ""
+		}
+		// A missing property is reported on the property instead of the parent object
+		if property, ok := e.Details()["property"].(string); ok && e.Type() == "required" {
+			field = strings.TrimPrefix(field+"."+property, ".")
+		}
+		violations = append(violations, Violation{Field: field, Description: e.Description()})
+	}
+	return violations, nil
+}
+
+// Parse returns a new Schema from s
+func Parse(s string) (*Schema, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(s), &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	if err := checkReferences(document); err != nil {
+		return nil, err
+	}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	return &Schema{schema: schema}, nil
+}
+
+// Validate returns an error if s is not a valid schema, an empty string is valid
+func Validate(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	_, err := Parse(s)
+	return err
+}
+
+// checkReferences returns an error when the document has a reference to another document
+func checkReferences(document interface{}) error {
+	switch v := document.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" && !strings.HasPrefix(ref, "#") {
+				return fmt.Errorf("%w: reference %q is not allowed", ErrInvalidSchema, ref)
+			}
+			if err := checkReferences(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := checkReferences(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const orderSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"type": "object",
+	"required": ["id", "customer"],
+	"properties": {
+		"id": {"type": "integer"},
+		"customer": {"$ref": "#/definitions/customer"}
+	},
+	"definitions": {
+		"customer": {
+			"type": "object",
+			"required": ["name"],
+			"properties": {"name": {"type": "string"}}
+		}
+	}
+}`
+
+func TestSchema(t *testing.T) {
+	s, err := Parse(orderSchema)
+	assert.Nil(t, err)
+
+	tables := []struct {
+		data   string
+		fields []string
+	}{
+		{`{"id": 1, "customer": {"name": "Allisson"}}`, []string{}},
+		{`{"id": "1", "customer": {"name": "Allisson"}}`, []string{"id"}},
+		{`{"id": 1, "customer": {}}`, []string{"customer.name"}},
+		{`{"customer": {"name": 1}}`, []string{"id", "customer.name"}},
+		{`[]`, []string{""}},
+	}
+
+	for _, table := range tables {
+		violations, err := s.Violations([]byte(table.data))
+		assert.Nil(t, err, table.data)
+		fields := []string{}
+		for _, violation := range violations {
+			fields = append(fields, violation.Field)
+			assert.NotEqual(t, "", violation.Description)
+		}
+		assert.ElementsMatch(t, table.fields, fields, table.data)
+	}
+
+	_, err = s.Violations([]byte(`not json`))
+	assert.Equal(t, ErrInvalidData, err)
+}
+
+func TestSchemaErrors(t *testing.T) {
+	schemas := []string{
+		`{"type": `,
+		`{"type": "unknown"}`,
+		`{"properties": {"id": {"$ref": "https://example.com/schema.json"}}}`,
+	}
+	for _, s := range schemas {
+		_, err := Parse(s)
+		assert.True(t, errors.Is(err, ErrInvalidSchema), s)
+		assert.True(t, errors.Is(Validate(s), ErrInvalidSchema), s)
+	}
+	assert.Nil(t, Validate(""))
+}
@@ -1,6 +1,9 @@
 package hammer
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // TopicService interface
 type TopicService interface {
@@ -11,12 +14,21 @@ type TopicService interface {
 	Delete(id string) error
 }
 
+// TopicSchemaService interface
+type TopicSchemaService interface {
+	Find(topicID string, version int) (TopicSchema, error)
+	Update(topicSchema *TopicSchema) error
+}
+
 // SubscriptionService interface
 type SubscriptionService interface {
 	Find(id string) (Subscription, error)
 	FindAll(findOptions FindOptions) ([]Subscription, error)
 	Create(subscription *Subscription) error
 	Update(subscription *Subscription) error
+	Pause(id string) (Subscription, error)
+	Resume(id string) (Subscription, error)
+	PreviewTransform(subscription *Subscription, message *Message) (Message, error)
 	Delete(id string) error
 }
 
@@ -25,6 +37,7 @@ type MessageService interface {
 	Find(id string) (Message, error)
 	FindAll(findOptions FindOptions) ([]Message, error)
 	Create(message *Message) error
+	CreateBatch(messages []*Message) ([]error, error)
 	Delete(id string) error
 }
 
@@ -32,8 +45,12 @@ type MessageService interface {
 type DeliveryService interface {
 	Find(id string) (Delivery, error)
 	FindAll(findOptions FindOptions) ([]Delivery, error)
-	FindToDispatch(limit, offset int) ([]string, error)
-	Dispatch(delivery *Delivery, httpClient *http.Client) (DeliveryAttempt, error)
+	Claim(owner string, limit int) ([]Delivery, error)
+	Dispatch(ctx context.Context, delivery *Delivery, httpClient *http.Client) (DeliveryAttempt, error)
+	Redeliver(id string, extraAttempts int) (Delivery, error)
+	RedeliverAll(findOptions FindOptions, extraAttempts int) (int, error)
+	Cancel(id string) (Delivery, error)
+	CancelByMessage(messageID string) (int, error)
 }
 
 // DeliveryAttemptService interface
@@ -42,10 +59,16 @@ type DeliveryAttemptService interface {
 	FindAll(findOptions FindOptions) ([]DeliveryAttempt, error)
 }
 
+// CircuitBreakerService interface
+type CircuitBreakerService interface {
+	Find(subscriptionID string) (CircuitBreaker, error)
+	FindAll(findOptions FindOptions) ([]CircuitBreaker, error)
+}
+
 // WorkerService interface
 type WorkerService interface {
-	Run() error
-	Stop() error
+	Run(ctx context.Context) error
+	Stop(ctx context.Context) error
 }
 
 // MigrationService interface
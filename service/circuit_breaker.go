@@ -0,0 +1,58 @@
+package service
+
+import (
+	"github.com/allisson/hammer"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+var (
+	circuitBreakersDesc = prometheus.NewDesc(
+		"hammer_circuit_breakers",
+		"Number of subscription circuit breakers on each state.",
+		[]string{"state"},
+		nil,
+	)
+	circuitBreakerStates = []string{
+		hammer.CircuitBreakerStateClosed,
+		hammer.CircuitBreakerStateHalfOpen,
+		hammer.CircuitBreakerStateOpen,
+	}
+)
+
+// CircuitBreaker is a implementation of hammer.CircuitBreakerService and prometheus.Collector
+type CircuitBreaker struct {
+	circuitBreakerRepo hammer.CircuitBreakerRepository
+}
+
+// Find returns hammer.CircuitBreaker by subscription id
+func (c *CircuitBreaker) Find(subscriptionID string) (hammer.CircuitBreaker, error) {
+	return c.circuitBreakerRepo.Find(subscriptionID)
+}
+
+// FindAll returns []hammer.CircuitBreaker by findOptions
+func (c *CircuitBreaker) FindAll(findOptions hammer.FindOptions) ([]hammer.CircuitBreaker, error) {
+	return c.circuitBreakerRepo.FindAll(findOptions)
+}
+
+// Describe sends the circuit breaker metrics descriptors to ch
+func (c *CircuitBreaker) Describe(ch chan<- *prometheus.Desc) {
+	ch <- circuitBreakersDesc
+}
+
+// Collect sends the circuit breaker metrics to ch, the circuit breakers are counted on repository on each scrape
+func (c *CircuitBreaker) Collect(ch chan<- prometheus.Metric) {
+	counts, err := c.circuitBreakerRepo.CountByState()
+	if err != nil {
+		logger.Error("circuit-breaker-collect", zap.Error(err))
+		return
+	}
+	for _, state := range circuitBreakerStates {
+		ch <- prometheus.MustNewConstMetric(circuitBreakersDesc, prometheus.GaugeValue, float64(counts[state]), state)
+	}
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker with CircuitBreakerRepo
+func NewCircuitBreaker(circuitBreakerRepo hammer.CircuitBreakerRepository) CircuitBreaker {
+	return CircuitBreaker{circuitBreakerRepo: circuitBreakerRepo}
+}
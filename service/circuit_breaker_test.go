@@ -0,0 +1,58 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/allisson/hammer"
+	"github.com/allisson/hammer/mocks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("Test Find", func(t *testing.T) {
+		expectedCircuitBreaker := hammer.CircuitBreaker{SubscriptionID: "subscription", State: hammer.CircuitBreakerStateOpen}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		circuitBreakerService := NewCircuitBreaker(circuitBreakerRepo)
+		circuitBreakerRepo.On("Find", mock.Anything).Return(expectedCircuitBreaker, nil)
+
+		circuitBreaker, err := circuitBreakerService.Find(expectedCircuitBreaker.SubscriptionID)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedCircuitBreaker, circuitBreaker)
+	})
+
+	t.Run("Test FindAll", func(t *testing.T) {
+		expectedCircuitBreakers := []hammer.CircuitBreaker{
+			{SubscriptionID: "subscription1", State: hammer.CircuitBreakerStateClosed},
+			{SubscriptionID: "subscription2", State: hammer.CircuitBreakerStateOpen},
+		}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		circuitBreakerService := NewCircuitBreaker(circuitBreakerRepo)
+		circuitBreakerRepo.On("FindAll", mock.Anything).Return(expectedCircuitBreakers, nil)
+
+		findOptions := hammer.FindOptions{}
+		circuitBreakers, err := circuitBreakerService.FindAll(findOptions)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedCircuitBreakers, circuitBreakers)
+	})
+
+	t.Run("Test Collect", func(t *testing.T) {
+		counts := map[string]int{hammer.CircuitBreakerStateClosed: 10, hammer.CircuitBreakerStateOpen: 2}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		circuitBreakerService := NewCircuitBreaker(circuitBreakerRepo)
+		circuitBreakerRepo.On("CountByState").Return(counts, nil)
+
+		expected := `
+			# HELP hammer_circuit_breakers Number of subscription circuit breakers on each state.
+			# TYPE hammer_circuit_breakers gauge
+			hammer_circuit_breakers{state="closed"} 10
+			hammer_circuit_breakers{state="half_open"} 0
+			hammer_circuit_breakers{state="open"} 2
+		`
+		err := testutil.CollectAndCompare(&circuitBreakerService, strings.NewReader(expected))
+		assert.Nil(t, err)
+		circuitBreakerRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+	})
+}
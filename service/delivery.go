@@ -2,57 +2,110 @@ package service
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"time"
 
 	"github.com/allisson/hammer"
+	"github.com/allisson/hammer/signature"
+	"go.uber.org/zap"
 )
 
+// deadLetterSavepoint is the savepoint created before the dead-letter message is published
+const deadLetterSavepoint = "dead_letter"
+
 type dispatchResponse struct {
 	Request            string
 	Response           string
 	ResponseStatusCode int
 	ExecutionDuration  int
 	Success            bool
+	PermanentFailure   bool
+	Throttled          bool
+	RetryAfter         time.Duration
 	Error              string
 }
 
-func makeRequest(delivery *hammer.Delivery, httpClient *http.Client) dispatchResponse {
+// dumpRequest returns the request dump with the values of hammer.RedactedHeaders redacted
+func dumpRequest(request *http.Request) ([]byte, error) {
+	dump := request.Clone(request.Context())
+	for _, name := range hammer.RedactedHeaders {
+		if dump.Header.Get(name) != "" {
+			dump.Header.Set(name, hammer.RedactedHeaderValue)
+		}
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	dump.Body = body
+	return httputil.DumpRequest(dump, true)
+}
+
+// matchStatusCode returns true when statusCode is on the status code ranges, invalid ranges don't match
+func matchStatusCode(statusCodeRanges string, statusCode int) bool {
+	ranges, err := hammer.ParseStatusCodeRanges(statusCodeRanges)
+	if err != nil {
+		return false
+	}
+	for _, r := range ranges {
+		if r.Contains(statusCode) {
+			return true
+		}
+	}
+	return false
+}
+
+// redirectClient returns httpClient configured with the delivery redirect policy
+func redirectClient(delivery *hammer.Delivery, httpClient *http.Client) *http.Client {
+	if delivery.RedirectPolicy != hammer.RedirectPolicyNone {
+		return httpClient
+	}
+	client := *httpClient
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &client
+}
+
+func makeRequest(ctx context.Context, delivery *hammer.Delivery, httpClient *http.Client) dispatchResponse {
 	dr := dispatchResponse{}
 
 	// Create payload
-	cloudEvent := hammer.CloudEventPayload{
-		SpecVersion:     "1.0",
-		Type:            "hammer.message.created",
-		Source:          fmt.Sprintf("/v1/messages/%s", delivery.MessageID),
-		ID:              delivery.ID,
-		Time:            delivery.CreatedAt,
-		SecretToken:     delivery.SecretToken,
-		MessageID:       delivery.MessageID,
-		SubscriptionID:  delivery.SubscriptionID,
-		TopicID:         delivery.TopicID,
-		DataContentType: delivery.ContentType,
-		DataBase64:      delivery.Data,
-	}
-
-	// Convert to json
-	requestBody, err := json.Marshal(&cloudEvent)
+	transformed, err := transformDelivery(delivery)
+	if err != nil {
+		dr.Error = err.Error()
+		return dr
+	}
+	requestPayload, err := makePayload(transformed)
 	if err != nil {
 		dr.Error = err.Error()
 		return dr
 	}
 
 	// Prepare request
-	request, err := http.NewRequest("POST", delivery.URL, bytes.NewBuffer(requestBody))
+	method := delivery.HTTPMethod
+	if method == "" {
+		method = http.MethodPost
+	}
+	request, err := http.NewRequestWithContext(ctx, method, delivery.URL, bytes.NewBuffer(requestPayload.Body))
 	if err != nil {
 		dr.Error = err.Error()
 		return dr
 	}
-	request.Header.Set("Content-Type", "application/json")
-	requestDump, err := httputil.DumpRequest(request, true)
+	for name, value := range delivery.Headers {
+		request.Header.Set(name, value)
+	}
+	for name, values := range requestPayload.Header {
+		request.Header[name] = values
+	}
+	if delivery.SigningMode != hammer.SigningModeLegacy {
+		signature.SetHeaders(request.Header, delivery.SecretToken, time.Now(), requestPayload.Body)
+	}
+	requestDump, err := dumpRequest(request)
 	if err != nil {
 		dr.Error = err.Error()
 		return dr
@@ -61,7 +114,7 @@ func makeRequest(delivery *hammer.Delivery, httpClient *http.Client) dispatchRes
 
 	// Make request
 	start := time.Now()
-	response, err := httpClient.Do(request)
+	response, err := redirectClient(delivery, httpClient).Do(request)
 	if err != nil {
 		dr.Error = err.Error()
 		return dr
@@ -77,9 +130,19 @@ func makeRequest(delivery *hammer.Delivery, httpClient *http.Client) dispatchRes
 	// Update dispatch response
 	dr.ResponseStatusCode = response.StatusCode
 	dr.ExecutionDuration = int(latency.Milliseconds())
+	successStatusCodes := delivery.SuccessStatusCodes
+	if successStatusCodes == "" {
+		successStatusCodes = hammer.DefaultSuccessStatusCodes
+	}
+	dr.Success = matchStatusCode(successStatusCodes, dr.ResponseStatusCode)
+	dr.PermanentFailure = !dr.Success && matchStatusCode(delivery.PermanentFailureStatusCodes, dr.ResponseStatusCode)
+
+	// Throttled responses are retried after the Retry-After header delay
 	switch dr.ResponseStatusCode {
-	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
-		dr.Success = true
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		if !dr.Success {
+			dr.RetryAfter, dr.Throttled = parseRetryAfter(response.Header.Get("Retry-After"), time.Now())
+		}
 	}
 
 	return dr
@@ -87,8 +150,12 @@ func makeRequest(delivery *hammer.Delivery, httpClient *http.Client) dispatchRes
 
 // Delivery is a implementation of hammer.DeliveryService
 type Delivery struct {
+	topicRepo           hammer.TopicRepository
+	messageRepo         hammer.MessageRepository
+	subscriptionRepo    hammer.SubscriptionRepository
 	deliveryRepo        hammer.DeliveryRepository
 	deliveryAttemptRepo hammer.DeliveryAttemptRepository
+	circuitBreakerRepo  hammer.CircuitBreakerRepository
 	txFactoryRepo       hammer.TxFactoryRepository
 }
 
@@ -102,20 +169,80 @@ func (d *Delivery) FindAll(findOptions hammer.FindOptions) ([]hammer.Delivery, e
 	return d.deliveryRepo.FindAll(findOptions)
 }
 
-// FindToDispatch returns []string ready to dispatch by limit and offset
-func (d *Delivery) FindToDispatch(limit, offset int) ([]string, error) {
-	return d.deliveryRepo.FindToDispatch(limit, offset)
+// Claim leases up to limit deliveries ready to dispatch to owner
+func (d *Delivery) Claim(owner string, limit int) ([]hammer.Delivery, error) {
+	leaseDuration := time.Duration(hammer.WorkerLeaseDuration) * time.Second
+	circuitBreakerOpenTimeout := time.Duration(hammer.CircuitBreakerOpenTimeout) * time.Second
+	return d.deliveryRepo.Claim(owner, limit, leaseDuration, circuitBreakerOpenTimeout)
+}
+
+// recordCircuitBreaker records the delivery attempt result on the subscription circuit breaker,
+// failures are ignored when the circuit breaker is disabled but a success still closes it
+func (d *Delivery) recordCircuitBreaker(tx hammer.TxRepository, subscriptionID string, success bool) error {
+	if success {
+		return d.circuitBreakerRepo.RecordSuccess(tx, subscriptionID)
+	}
+	if hammer.CircuitBreakerThreshold < 1 {
+		return nil
+	}
+	return d.circuitBreakerRepo.RecordFailure(tx, subscriptionID, hammer.CircuitBreakerThreshold)
+}
+
+// releaseLease stores the delivery without the lease, so it can be claimed again immediately
+func (d *Delivery) releaseLease(delivery *hammer.Delivery) error {
+	// Start tx
+	tx, err := d.txFactoryRepo.New()
+	if err != nil {
+		return err
+	}
+
+	// Verify if the lease still belongs to the delivery owner
+	current, err := d.deliveryRepo.FindForUpdate(tx, delivery.ID)
+	if err != nil {
+		rollback(tx, "delivery-release-lease-find")
+		return err
+	}
+	if current.LeaseOwner != delivery.LeaseOwner {
+		rollback(tx, "delivery-release-lease-lost")
+		return nil
+	}
+
+	// Update delivery
+	current.LeaseOwner = ""
+	current.LeaseExpiresAt = time.Now().UTC()
+	err = d.deliveryRepo.Store(tx, &current)
+	if err != nil {
+		rollback(tx, "delivery-release-lease-store")
+		return err
+	}
+
+	// Commit tx
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "delivery-release-lease-commit")
+		return err
+	}
+
+	return nil
 }
 
-// Dispatch message to destination
-func (d *Delivery) Dispatch(delivery *hammer.Delivery, httpClient *http.Client) (hammer.DeliveryAttempt, error) {
+// Dispatch message to destination, when ctx is cancelled before a response is received
+// no attempt is stored and the delivery is left pending for retry
+func (d *Delivery) Dispatch(ctx context.Context, delivery *hammer.Delivery, httpClient *http.Client) (hammer.DeliveryAttempt, error) {
 	// Generate delivery attempt id
 	id, err := generateULID()
 	if err != nil {
 		return hammer.DeliveryAttempt{}, err
 	}
 
-	dr := makeRequest(delivery, httpClient)
+	dr := makeRequest(ctx, delivery, httpClient)
+	if dr.ResponseStatusCode == 0 && ctx.Err() != nil {
+		err = d.releaseLease(delivery)
+		if err != nil {
+			return hammer.DeliveryAttempt{}, err
+		}
+		return hammer.DeliveryAttempt{}, hammer.ErrDeliveryDispatchInterrupted
+	}
 
 	// Start tx
 	tx, err := d.txFactoryRepo.New()
@@ -140,17 +267,50 @@ func (d *Delivery) Dispatch(delivery *hammer.Delivery, httpClient *http.Client)
 		rollback(tx, "delivery-dispatch-delivery-attempt-store")
 		return hammer.DeliveryAttempt{}, err
 	}
+	err = d.recordCircuitBreaker(tx, delivery.SubscriptionID, deliveryAttempt.Success)
+	if err != nil {
+		rollback(tx, "delivery-dispatch-circuit-breaker")
+		return hammer.DeliveryAttempt{}, err
+	}
 
-	// Update delivery
-	delivery.DeliveryAttempts++
+	// Reload and lock delivery, it could be cancelled while the request was made
+	current, err := d.deliveryRepo.FindForUpdate(tx, delivery.ID)
+	if err != nil {
+		rollback(tx, "delivery-dispatch-delivery-find")
+		return hammer.DeliveryAttempt{}, err
+	}
+
+	// The lease expired and the delivery was claimed by another worker, only the attempt is stored
+	if current.LeaseOwner != delivery.LeaseOwner {
+		logger.Warn("delivery-dispatch-lease-lost", zap.String("delivery_id", delivery.ID), zap.String("lease_owner", current.LeaseOwner))
+		err = tx.Commit()
+		if err != nil {
+			rollback(tx, "delivery-dispatch-commit")
+			return hammer.DeliveryAttempt{}, err
+		}
+		return deliveryAttempt, nil
+	}
+
+	// Update delivery and release lease
+	if dr.Throttled && !hammer.RetryAfterCountAttempts && delivery.ThrottledAttempts < hammer.RetryAfterMaxThrottledAttempts {
+		delivery.ThrottledAttempts++
+	} else {
+		delivery.DeliveryAttempts++
+	}
 	delivery.UpdatedAt = time.Now().UTC()
-	if deliveryAttempt.Success {
+	delivery.LeaseOwner = ""
+	delivery.LeaseExpiresAt = delivery.UpdatedAt
+	if current.Status == hammer.DeliveryStatusCancelled {
+		delivery.Status = hammer.DeliveryStatusCancelled
+	} else if deliveryAttempt.Success {
 		delivery.Status = hammer.DeliveryStatusCompleted
 	} else {
-		if delivery.DeliveryAttempts >= delivery.MaxDeliveryAttempts {
+		if delivery.DeliveryAttempts >= delivery.MaxDeliveryAttempts || dr.PermanentFailure {
 			delivery.Status = hammer.DeliveryStatusFailed
+		} else if dr.Throttled && dr.RetryAfter > 0 {
+			delivery.ScheduledAt = time.Now().UTC().Add(dr.RetryAfter)
 		} else {
-			delivery.ScheduledAt = time.Now().UTC().Add(time.Duration(delivery.DeliveryAttemptDelay) * time.Second)
+			delivery.ScheduledAt = time.Now().UTC().Add(retryDelay(delivery))
 		}
 	}
 	err = d.deliveryRepo.Store(tx, delivery)
@@ -159,6 +319,34 @@ func (d *Delivery) Dispatch(delivery *hammer.Delivery, httpClient *http.Client)
 		return hammer.DeliveryAttempt{}, err
 	}
 
+	// Pause the subscription when the receiver is gone
+	if deliveryAttempt.ResponseStatusCode == http.StatusGone && delivery.PauseOnGone {
+		err = d.pauseSubscription(tx, delivery.SubscriptionID)
+		if err != nil {
+			rollback(tx, "delivery-dispatch-pause-subscription")
+			return hammer.DeliveryAttempt{}, err
+		}
+	}
+
+	// Publish failed delivery on dead-letter topic, when the publish fails only the dead-letter message is
+	// rolled back, the attempt and the failed delivery are kept so the delivery is not dispatched again
+	if delivery.Status == hammer.DeliveryStatusFailed && delivery.DeadLetterTopicID != "" {
+		err = tx.Savepoint(deadLetterSavepoint)
+		if err != nil {
+			rollback(tx, "delivery-dispatch-dead-letter-savepoint")
+			return hammer.DeliveryAttempt{}, err
+		}
+		err = d.publishDeadLetter(tx, delivery, &deliveryAttempt)
+		if err != nil {
+			logger.Error("delivery-dispatch-dead-letter", zap.String("delivery_id", delivery.ID), zap.String("topic_id", delivery.DeadLetterTopicID), zap.Error(err))
+			err = tx.RollbackToSavepoint(deadLetterSavepoint)
+			if err != nil {
+				rollback(tx, "delivery-dispatch-dead-letter-rollback")
+				return hammer.DeliveryAttempt{}, err
+			}
+		}
+	}
+
 	// Commit tx
 	err = tx.Commit()
 	if err != nil {
@@ -169,11 +357,275 @@ func (d *Delivery) Dispatch(delivery *hammer.Delivery, httpClient *http.Client)
 	return deliveryAttempt, nil
 }
 
+// pauseSubscription pauses the subscription of the delivery, a deleted subscription is ignored
+func (d *Delivery) pauseSubscription(tx hammer.TxRepository, subscriptionID string) error {
+	logger.Warn("delivery-subscription-paused-on-gone", zap.String("subscription_id", subscriptionID))
+	return d.subscriptionRepo.Pause(tx, subscriptionID)
+}
+
+// publishDeadLetter creates a message on the dead-letter topic with the failed delivery payload and failure metadata
+func (d *Delivery) publishDeadLetter(tx hammer.TxRepository, delivery *hammer.Delivery, deliveryAttempt *hammer.DeliveryAttempt) error {
+	// Verify if dead-letter topic still exists, the topic can't be deleted until tx ends
+	topic, err := d.topicRepo.FindForShare(tx, delivery.DeadLetterTopicID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Warn("delivery-dead-letter-topic-does-not-exists", zap.String("delivery_id", delivery.ID), zap.String("topic_id", delivery.DeadLetterTopicID))
+			return nil
+		}
+		return err
+	}
+
+	// Create message
+	payload := hammer.DeadLetterPayload{
+		DeliveryID:             delivery.ID,
+		SubscriptionID:         delivery.SubscriptionID,
+		TopicID:                delivery.TopicID,
+		MessageID:              delivery.MessageID,
+		ContentType:            delivery.ContentType,
+		DataBase64:             delivery.Data,
+		Attributes:             delivery.Attributes,
+		DeliveryAttempts:       delivery.DeliveryAttempts,
+		LastDeliveryAttemptID:  deliveryAttempt.ID,
+		LastResponseStatusCode: deliveryAttempt.ResponseStatusCode,
+		LastError:              deliveryAttempt.Error,
+		FailedAt:               delivery.UpdatedAt,
+	}
+	data, err := json.Marshal(&payload)
+	if err != nil {
+		return err
+	}
+	message := hammer.Message{
+		TopicID:     delivery.DeadLetterTopicID,
+		ContentType: "application/json",
+		Data:        string(data),
+		Attributes:  delivery.Attributes,
+		OrderingKey: delivery.OrderingKey,
+	}
+
+	// Validate data against the dead-letter topic schema
+	s, err := topicSchema(&topic)
+	if err != nil {
+		return err
+	}
+	if s != nil {
+		if err := message.ValidateSchema(s); err != nil {
+			return err
+		}
+	}
+	document, err := prepareMessage(&message, &topic)
+	if err != nil {
+		return err
+	}
+	err = d.messageRepo.Store(tx, &message)
+	if err != nil {
+		return err
+	}
+
+	// Create deliveries
+	subscriptions, err := d.subscriptionRepo.FindByTopic(tx, message.TopicID)
+	if err != nil {
+		return err
+	}
+	deliveries, err := makeDeliveries(&message, subscriptions, document)
+	if err != nil {
+		return err
+	}
+	for i := range deliveries {
+		err = d.deliveryRepo.Store(tx, &deliveries[i])
+		if err != nil {
+			return err
+		}
+	}
+	if len(deliveries) > 0 {
+		return d.deliveryRepo.Notify(tx)
+	}
+
+	return nil
+}
+
+// Redeliver schedules the delivery to be dispatched again immediately
+func (d *Delivery) Redeliver(id string, extraAttempts int) (hammer.Delivery, error) {
+	// Start tx
+	tx, err := d.txFactoryRepo.New()
+	if err != nil {
+		return hammer.Delivery{}, err
+	}
+
+	// Update delivery, only a completed or failed delivery without lease is changed
+	delivery, err := d.deliveryRepo.Redeliver(tx, id, extraAttempts)
+	if err != nil {
+		rollback(tx, "delivery-redeliver")
+		if err != sql.ErrNoRows {
+			return delivery, err
+		}
+		_, err = d.deliveryRepo.Find(id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return delivery, hammer.ErrDeliveryDoesNotExists
+			}
+			return delivery, err
+		}
+		return delivery, hammer.ErrDeliveryCannotBeRedelivered
+	}
+	err = d.deliveryRepo.Notify(tx)
+	if err != nil {
+		rollback(tx, "delivery-redeliver-notify")
+		return delivery, err
+	}
+
+	// Commit tx
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "delivery-redeliver-commit")
+		return delivery, err
+	}
+
+	return delivery, nil
+}
+
+// RedeliverAll schedules all deliveries found by findOptions to be dispatched again immediately,
+// deliveries that are not completed or failed are skipped
+func (d *Delivery) RedeliverAll(findOptions hammer.FindOptions, extraAttempts int) (int, error) {
+	count := 0
+	lastID := ""
+	for {
+		// Load the next page ordered by id, the filters could match the updated deliveries again
+		pageOptions := hammer.FindOptions{
+			FindFilters:    append([]hammer.FindFilter{}, findOptions.FindFilters...),
+			FindPagination: &hammer.FindPagination{Limit: uint(hammer.MaxPaginationLimit)},
+			FindOrderBy:    &hammer.FindOrderBy{FieldName: "id"},
+		}
+		if lastID != "" {
+			pageOptions.FindFilters = append(pageOptions.FindFilters, hammer.FindFilter{FieldName: "id", Operator: "gt", Value: lastID})
+		}
+		deliveries, err := d.deliveryRepo.FindAll(pageOptions)
+		if err != nil {
+			return count, err
+		}
+		if len(deliveries) == 0 {
+			return count, nil
+		}
+		lastID = deliveries[len(deliveries)-1].ID
+
+		pageCount, err := d.redeliverPage(deliveries, extraAttempts)
+		count += pageCount
+		if err != nil {
+			return count, err
+		}
+		if len(deliveries) < hammer.MaxPaginationLimit {
+			return count, nil
+		}
+	}
+}
+
+// redeliverPage schedules the deliveries in one tx and returns how many were changed
+func (d *Delivery) redeliverPage(deliveries []hammer.Delivery, extraAttempts int) (int, error) {
+	// Start tx
+	tx, err := d.txFactoryRepo.New()
+	if err != nil {
+		return 0, err
+	}
+
+	// Update deliveries
+	count := 0
+	for i := range deliveries {
+		_, err = d.deliveryRepo.Redeliver(tx, deliveries[i].ID, extraAttempts)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			rollback(tx, "delivery-redeliver-all")
+			return 0, err
+		}
+		count++
+	}
+	if count > 0 {
+		err = d.deliveryRepo.Notify(tx)
+		if err != nil {
+			rollback(tx, "delivery-redeliver-all-notify")
+			return 0, err
+		}
+	}
+
+	// Commit tx
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "delivery-redeliver-all-commit")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Cancel stops a pending delivery from being dispatched
+func (d *Delivery) Cancel(id string) (hammer.Delivery, error) {
+	// Start tx
+	tx, err := d.txFactoryRepo.New()
+	if err != nil {
+		return hammer.Delivery{}, err
+	}
+
+	// Update delivery, only a pending delivery is changed
+	delivery, err := d.deliveryRepo.Cancel(tx, id)
+	if err != nil {
+		rollback(tx, "delivery-cancel")
+		if err != sql.ErrNoRows {
+			return delivery, err
+		}
+		_, err = d.deliveryRepo.Find(id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return delivery, hammer.ErrDeliveryDoesNotExists
+			}
+			return delivery, err
+		}
+		return delivery, hammer.ErrDeliveryIsNotPending
+	}
+
+	// Commit tx
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "delivery-cancel-commit")
+		return delivery, err
+	}
+
+	return delivery, nil
+}
+
+// CancelByMessage stops all pending deliveries of the message from being dispatched
+func (d *Delivery) CancelByMessage(messageID string) (int, error) {
+	// Start tx
+	tx, err := d.txFactoryRepo.New()
+	if err != nil {
+		return 0, err
+	}
+
+	// Update deliveries
+	count, err := d.deliveryRepo.CancelByMessage(tx, messageID)
+	if err != nil {
+		rollback(tx, "delivery-cancel-by-message")
+		return 0, err
+	}
+
+	// Commit tx
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "delivery-cancel-by-message-commit")
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // NewDelivery returns a new Delivery with DeliveryRepo
-func NewDelivery(deliveryRepo hammer.DeliveryRepository, deliveryAttemptRepo hammer.DeliveryAttemptRepository, txFactoryRepo hammer.TxFactoryRepository) Delivery {
+func NewDelivery(topicRepo hammer.TopicRepository, messageRepo hammer.MessageRepository, subscriptionRepo hammer.SubscriptionRepository, deliveryRepo hammer.DeliveryRepository, deliveryAttemptRepo hammer.DeliveryAttemptRepository, circuitBreakerRepo hammer.CircuitBreakerRepository, txFactoryRepo hammer.TxFactoryRepository) Delivery {
 	return Delivery{
+		topicRepo:           topicRepo,
+		messageRepo:         messageRepo,
+		subscriptionRepo:    subscriptionRepo,
 		deliveryRepo:        deliveryRepo,
 		deliveryAttemptRepo: deliveryAttemptRepo,
+		circuitBreakerRepo:  circuitBreakerRepo,
 		txFactoryRepo:       txFactoryRepo,
 	}
 }
@@ -1,12 +1,20 @@
 package service
 
 import (
+	"context"
+	"database/sql"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/allisson/hammer"
 	"github.com/allisson/hammer/mocks"
+	"github.com/allisson/hammer/signature"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -14,10 +22,14 @@ import (
 func TestDelivery(t *testing.T) {
 	t.Run("Test Find", func(t *testing.T) {
 		expectedDelivery := hammer.MakeTestDelivery()
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
 		deliveryRepo := &mocks.DeliveryRepository{}
 		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
 		txFactoryRepo := &mocks.TxFactoryRepository{}
-		deliveryService := NewDelivery(deliveryRepo, deliveryAttemptRepo, txFactoryRepo)
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
 		deliveryRepo.On("Find", mock.Anything).Return(expectedDelivery, nil)
 
 		delivery, err := deliveryService.Find(expectedDelivery.ID)
@@ -27,10 +39,14 @@ func TestDelivery(t *testing.T) {
 
 	t.Run("Test FindAll", func(t *testing.T) {
 		expectedDeliveries := []hammer.Delivery{hammer.MakeTestDelivery()}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
 		deliveryRepo := &mocks.DeliveryRepository{}
 		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
 		txFactoryRepo := &mocks.TxFactoryRepository{}
-		deliveryService := NewDelivery(deliveryRepo, deliveryAttemptRepo, txFactoryRepo)
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
 		deliveryRepo.On("FindAll", mock.Anything).Return(expectedDeliveries, nil)
 
 		findOptions := hammer.FindOptions{
@@ -44,15 +60,19 @@ func TestDelivery(t *testing.T) {
 		assert.Equal(t, expectedDeliveries, deliveries)
 	})
 
-	t.Run("Test FindToDispatch", func(t *testing.T) {
-		expectedDeliveries := []string{hammer.MakeTestDelivery().ID}
+	t.Run("Test Claim", func(t *testing.T) {
+		expectedDeliveries := []hammer.Delivery{hammer.MakeTestDelivery()}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
 		deliveryRepo := &mocks.DeliveryRepository{}
 		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
 		txFactoryRepo := &mocks.TxFactoryRepository{}
-		deliveryService := NewDelivery(deliveryRepo, deliveryAttemptRepo, txFactoryRepo)
-		deliveryRepo.On("FindToDispatch", mock.Anything, mock.Anything).Return(expectedDeliveries, nil)
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		deliveryRepo.On("Claim", "worker", 50, time.Duration(hammer.WorkerLeaseDuration)*time.Second, time.Duration(hammer.CircuitBreakerOpenTimeout)*time.Second).Return(expectedDeliveries, nil)
 
-		deliveries, err := deliveryService.FindToDispatch(50, 0)
+		deliveries, err := deliveryService.Claim("worker", 50)
 		assert.Nil(t, err)
 		assert.Equal(t, expectedDeliveries, deliveries)
 	})
@@ -65,23 +85,182 @@ func TestDelivery(t *testing.T) {
 		defer httpServer.Close()
 		delivery := hammer.MakeTestDelivery()
 		delivery.URL = httpServer.URL
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
 		deliveryRepo := &mocks.DeliveryRepository{}
 		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
 		txFactoryRepo := &mocks.TxFactoryRepository{}
 		txRepo := &mocks.TxRepository{}
-		deliveryService := NewDelivery(deliveryRepo, deliveryAttemptRepo, txFactoryRepo)
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
 		txFactoryRepo.On("New").Return(txRepo, nil)
 		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
 		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
 		txRepo.On("Commit").Return(nil)
 
 		deliveryAttemps := delivery.DeliveryAttempts
-		deliveryAttempt, err := deliveryService.Dispatch(&delivery, httpServer.Client())
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
 		assert.Nil(t, err)
 		assert.Equal(t, delivery.DeliveryAttempts, deliveryAttemps+1)
 		assert.Equal(t, hammer.DeliveryStatusCompleted, delivery.Status)
 		assert.Equal(t, true, deliveryAttempt.Success)
 		assert.Equal(t, http.StatusOK, deliveryAttempt.ResponseStatusCode)
+		assert.Equal(t, "", delivery.LeaseOwner)
+	})
+
+	t.Run("Test Dispatch with lease lost", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// nolint
+			w.Write([]byte(`OK`))
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.LeaseOwner = "worker-1"
+		current := delivery
+		current.LeaseOwner = "worker-2"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(current, nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, true, deliveryAttempt.Success)
+		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
+		deliveryRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Dispatch interrupted", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		unblock := make(chan struct{})
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cancel()
+			<-unblock
+		}))
+		defer httpServer.Close()
+		defer close(unblock)
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.LeaseOwner = "worker"
+		delivery.LeaseExpiresAt = time.Now().UTC().Add(time.Minute)
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		var storedDelivery *hammer.Delivery
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			storedDelivery = args.Get(1).(*hammer.Delivery)
+		}).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		_, err := deliveryService.Dispatch(ctx, &delivery, httpServer.Client())
+		assert.Equal(t, hammer.ErrDeliveryDispatchInterrupted, err)
+		deliveryAttemptRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+		assert.Equal(t, hammer.DeliveryStatusPending, storedDelivery.Status)
+		assert.Equal(t, delivery.DeliveryAttempts, storedDelivery.DeliveryAttempts)
+		assert.Equal(t, "", storedDelivery.LeaseOwner)
+		assert.True(t, storedDelivery.LeaseExpiresAt.Before(delivery.LeaseExpiresAt))
+	})
+
+	t.Run("Test Dispatch with hmac signing mode", func(t *testing.T) {
+		var requestBody []byte
+		var requestHeader http.Header
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestBody, _ = ioutil.ReadAll(r.Body)
+			requestHeader = r.Header
+			// nolint
+			w.Write([]byte(`OK`))
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.SigningMode = hammer.SigningModeHMAC
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, true, deliveryAttempt.Success)
+		assert.NotContains(t, string(requestBody), delivery.SecretToken)
+		assert.NotContains(t, deliveryAttempt.Request, delivery.SecretToken)
+		err = signature.Verify(
+			delivery.SecretToken,
+			requestHeader.Get(signature.TimestampHeader),
+			requestHeader.Get(signature.SignatureHeader),
+			requestBody,
+			signature.DefaultTolerance,
+		)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Dispatch with legacy signing mode", func(t *testing.T) {
+		var requestBody []byte
+		var requestHeader http.Header
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestBody, _ = ioutil.ReadAll(r.Body)
+			requestHeader = r.Header
+			// nolint
+			w.Write([]byte(`OK`))
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.SigningMode = hammer.SigningModeLegacy
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, true, deliveryAttempt.Success)
+		assert.Contains(t, string(requestBody), fmt.Sprintf(`"secrettoken":"%s"`, delivery.SecretToken))
+		assert.Equal(t, "", requestHeader.Get(signature.SignatureHeader))
 	})
 
 	t.Run("Test Dispatch Error", func(t *testing.T) {
@@ -91,25 +270,32 @@ func TestDelivery(t *testing.T) {
 		defer httpServer.Close()
 		delivery := hammer.MakeTestDelivery()
 		delivery.URL = httpServer.URL
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
 		deliveryRepo := &mocks.DeliveryRepository{}
 		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
 		txFactoryRepo := &mocks.TxFactoryRepository{}
 		txRepo := &mocks.TxRepository{}
-		deliveryService := NewDelivery(deliveryRepo, deliveryAttemptRepo, txFactoryRepo)
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
 		txFactoryRepo.On("New").Return(txRepo, nil)
 		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
 		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		txRepo.On("Commit").Return(nil)
 
 		deliveryScheduledAt := delivery.ScheduledAt
 		deliveryAttemps := delivery.DeliveryAttempts
-		deliveryAttempt, err := deliveryService.Dispatch(&delivery, httpServer.Client())
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
 		assert.Nil(t, err)
 		assert.Equal(t, delivery.DeliveryAttempts, deliveryAttemps+1)
 		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
 		assert.True(t, delivery.ScheduledAt.After(deliveryScheduledAt))
 		assert.Equal(t, false, deliveryAttempt.Success)
 		assert.Equal(t, http.StatusNotFound, deliveryAttempt.ResponseStatusCode)
+		circuitBreakerRepo.AssertCalled(t, "RecordFailure", txRepo, delivery.SubscriptionID, hammer.CircuitBreakerThreshold)
 	})
 
 	t.Run("Test Dispatch MaxDeliveryAttempts Error", func(t *testing.T) {
@@ -120,19 +306,25 @@ func TestDelivery(t *testing.T) {
 		delivery := hammer.MakeTestDelivery()
 		delivery.URL = httpServer.URL
 		delivery.DeliveryAttempts = delivery.MaxDeliveryAttempts - 1
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
 		deliveryRepo := &mocks.DeliveryRepository{}
 		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
 		txFactoryRepo := &mocks.TxFactoryRepository{}
 		txRepo := &mocks.TxRepository{}
-		deliveryService := NewDelivery(deliveryRepo, deliveryAttemptRepo, txFactoryRepo)
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
 		txFactoryRepo.On("New").Return(txRepo, nil)
 		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
 		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		txRepo.On("Commit").Return(nil)
 
 		deliveryScheduledAt := delivery.ScheduledAt
 		deliveryAttemps := delivery.DeliveryAttempts
-		deliveryAttempt, err := deliveryService.Dispatch(&delivery, httpServer.Client())
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
 		assert.Nil(t, err)
 		assert.Equal(t, delivery.DeliveryAttempts, deliveryAttemps+1)
 		assert.Equal(t, hammer.DeliveryStatusFailed, delivery.Status)
@@ -140,4 +332,708 @@ func TestDelivery(t *testing.T) {
 		assert.Equal(t, false, deliveryAttempt.Success)
 		assert.Equal(t, http.StatusNotFound, deliveryAttempt.ResponseStatusCode)
 	})
+
+	t.Run("Test Dispatch Dead Letter", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "not_found", http.StatusNotFound)
+		}))
+		defer httpServer.Close()
+		deadLetterTopic := hammer.MakeTestTopic()
+		deadLetterSubscription := hammer.MakeTestSubscription()
+		deadLetterSubscription.TopicID = deadLetterTopic.ID
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.DeliveryAttempts = delivery.MaxDeliveryAttempts - 1
+		delivery.DeadLetterTopicID = deadLetterTopic.ID
+		delivery.Attributes = hammer.StringMap{"region": "br"}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		topicRepo.On("FindForShare", txRepo, deadLetterTopic.ID).Return(deadLetterTopic, nil)
+		subscriptionRepo.On("FindByTopic", txRepo, deadLetterTopic.ID).Return([]hammer.Subscription{deadLetterSubscription}, nil)
+		txRepo.On("Savepoint", mock.Anything).Return(nil)
+		var deadLetterMessage *hammer.Message
+		messageRepo.On("Store", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			deadLetterMessage = args.Get(1).(*hammer.Message)
+		}).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, deadLetterTopic.ID, deadLetterMessage.TopicID)
+		assert.Equal(t, "application/json", deadLetterMessage.ContentType)
+		assert.Equal(t, delivery.Attributes, deadLetterMessage.Attributes)
+		deliveryRepo.AssertNumberOfCalls(t, "Store", 2)
+
+		data, err := b64.StdEncoding.DecodeString(deadLetterMessage.Data)
+		assert.Nil(t, err)
+		payload := hammer.DeadLetterPayload{}
+		err = json.Unmarshal(data, &payload)
+		assert.Nil(t, err)
+		assert.Equal(t, delivery.ID, payload.DeliveryID)
+		assert.Equal(t, delivery.MessageID, payload.MessageID)
+		assert.Equal(t, delivery.Data, payload.DataBase64)
+		assert.Equal(t, delivery.MaxDeliveryAttempts, payload.DeliveryAttempts)
+		assert.Equal(t, deliveryAttempt.ID, payload.LastDeliveryAttemptID)
+		assert.Equal(t, http.StatusNotFound, payload.LastResponseStatusCode)
+	})
+
+	t.Run("Test Dispatch Dead Letter Topic Does Not Exists", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "not_found", http.StatusNotFound)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.DeliveryAttempts = delivery.MaxDeliveryAttempts - 1
+		delivery.DeadLetterTopicID = "dead-letter"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		topicRepo.On("FindForShare", mock.Anything, mock.Anything).Return(hammer.Topic{}, sql.ErrNoRows)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Savepoint", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		_, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusFailed, delivery.Status)
+		messageRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Dispatch Dead Letter with publish error", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "not_found", http.StatusNotFound)
+		}))
+		defer httpServer.Close()
+		deadLetterTopic := hammer.MakeTestTopic()
+		deadLetterTopic.Schema = `{"type": "object", "required": ["order_id"]}`
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.DeliveryAttempts = delivery.MaxDeliveryAttempts - 1
+		delivery.DeadLetterTopicID = deadLetterTopic.ID
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		topicRepo.On("FindForShare", txRepo, deadLetterTopic.ID).Return(deadLetterTopic, nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Savepoint", deadLetterSavepoint).Return(nil)
+		txRepo.On("RollbackToSavepoint", deadLetterSavepoint).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.False(t, deliveryAttempt.Success)
+		assert.Equal(t, hammer.DeliveryStatusFailed, delivery.Status)
+		messageRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+		txRepo.AssertCalled(t, "RollbackToSavepoint", deadLetterSavepoint)
+		txRepo.AssertCalled(t, "Commit")
+		txRepo.AssertNotCalled(t, "Rollback")
+	})
+
+	t.Run("Test Redeliver", func(t *testing.T) {
+		expectedDelivery := hammer.MakeTestDelivery()
+		expectedDelivery.Status = hammer.DeliveryStatusPending
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Redeliver", txRepo, expectedDelivery.ID, 2).Return(expectedDelivery, nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		delivery, err := deliveryService.Redeliver(expectedDelivery.ID, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
+		deliveryRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Redeliver with delivery does not exists", func(t *testing.T) {
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Redeliver", txRepo, "id", 0).Return(hammer.Delivery{}, sql.ErrNoRows)
+		deliveryRepo.On("Find", "id").Return(hammer.Delivery{}, sql.ErrNoRows)
+		txRepo.On("Rollback").Return(nil)
+
+		_, err := deliveryService.Redeliver("id", 0)
+		assert.Equal(t, hammer.ErrDeliveryDoesNotExists, err)
+	})
+
+	t.Run("Test Redeliver with delivery leased by a worker", func(t *testing.T) {
+		expectedDelivery := hammer.MakeTestDelivery()
+		expectedDelivery.Status = hammer.DeliveryStatusPending
+		expectedDelivery.LeaseOwner = "worker"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Redeliver", txRepo, expectedDelivery.ID, 0).Return(hammer.Delivery{}, sql.ErrNoRows)
+		deliveryRepo.On("Find", expectedDelivery.ID).Return(expectedDelivery, nil)
+		txRepo.On("Rollback").Return(nil)
+
+		_, err := deliveryService.Redeliver(expectedDelivery.ID, 0)
+		assert.Equal(t, hammer.ErrDeliveryCannotBeRedelivered, err)
+		deliveryRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test RedeliverAll", func(t *testing.T) {
+		expectedDeliveries := []hammer.Delivery{hammer.MakeTestDelivery(), hammer.MakeTestDelivery()}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		deliveryRepo.On("FindAll", mock.Anything).Return(expectedDeliveries, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Redeliver", txRepo, expectedDeliveries[0].ID, 0).Return(expectedDeliveries[0], nil)
+		deliveryRepo.On("Redeliver", txRepo, expectedDeliveries[1].ID, 0).Return(hammer.Delivery{}, sql.ErrNoRows)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		findOptions := hammer.FindOptions{
+			FindFilters: []hammer.FindFilter{
+				{
+					FieldName: "status",
+					Operator:  "=",
+					Value:     hammer.DeliveryStatusFailed,
+				},
+			},
+		}
+		deliveries, err := deliveryService.RedeliverAll(findOptions, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, deliveries)
+		deliveryRepo.AssertNumberOfCalls(t, "FindAll", 1)
+		deliveryRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test RedeliverAll with pages", func(t *testing.T) {
+		firstPage := make([]hammer.Delivery, hammer.MaxPaginationLimit)
+		for i := range firstPage {
+			firstPage[i] = hammer.MakeTestDelivery()
+		}
+		secondPage := []hammer.Delivery{hammer.MakeTestDelivery()}
+		lastID := firstPage[len(firstPage)-1].ID
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		deliveryRepo.On("FindAll", mock.MatchedBy(func(findOptions hammer.FindOptions) bool {
+			return len(findOptions.FindFilters) == 1 && findOptions.FindPagination.Limit == uint(hammer.MaxPaginationLimit) && findOptions.FindOrderBy.FieldName == "id"
+		})).Return(firstPage, nil)
+		deliveryRepo.On("FindAll", mock.MatchedBy(func(findOptions hammer.FindOptions) bool {
+			return len(findOptions.FindFilters) == 2 && findOptions.FindFilters[1] == hammer.FindFilter{FieldName: "id", Operator: "gt", Value: lastID}
+		})).Return(secondPage, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Redeliver", txRepo, mock.Anything, 0).Return(hammer.Delivery{}, nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		findOptions := hammer.FindOptions{
+			FindFilters: []hammer.FindFilter{
+				{
+					FieldName: "status",
+					Operator:  "=",
+					Value:     hammer.DeliveryStatusFailed,
+				},
+			},
+		}
+		deliveries, err := deliveryService.RedeliverAll(findOptions, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.MaxPaginationLimit+1, deliveries)
+		deliveryRepo.AssertNumberOfCalls(t, "FindAll", 2)
+		txRepo.AssertNumberOfCalls(t, "Commit", 2)
+	})
+
+	t.Run("Test Dispatch with attributes", func(t *testing.T) {
+		var payload map[string]interface{}
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			// nolint
+			json.Unmarshal(body, &payload)
+			// nolint
+			w.Write([]byte(`OK`))
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.Attributes = hammer.StringMap{"traceid": "trace", "region": "us"}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		_, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, "trace", payload["traceid"])
+		assert.Equal(t, "us", payload["region"])
+		assert.Equal(t, delivery.ID, payload["id"])
+	})
+
+	t.Run("Test Dispatch with cancelled delivery", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// nolint
+			w.Write([]byte(`OK`))
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		cancelledDelivery := delivery
+		cancelledDelivery.Status = hammer.DeliveryStatusCancelled
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(cancelledDelivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusCancelled, delivery.Status)
+		assert.Equal(t, true, deliveryAttempt.Success)
+	})
+
+	t.Run("Test Cancel", func(t *testing.T) {
+		expectedDelivery := hammer.MakeTestDelivery()
+		expectedDelivery.Status = hammer.DeliveryStatusCancelled
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Cancel", txRepo, expectedDelivery.ID).Return(expectedDelivery, nil)
+		txRepo.On("Commit").Return(nil)
+
+		delivery, err := deliveryService.Cancel(expectedDelivery.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusCancelled, delivery.Status)
+		deliveryRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Cancel with delivery is not pending", func(t *testing.T) {
+		expectedDelivery := hammer.MakeTestDelivery()
+		expectedDelivery.Status = hammer.DeliveryStatusCompleted
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Cancel", txRepo, expectedDelivery.ID).Return(hammer.Delivery{}, sql.ErrNoRows)
+		deliveryRepo.On("Find", expectedDelivery.ID).Return(expectedDelivery, nil)
+		txRepo.On("Rollback").Return(nil)
+
+		_, err := deliveryService.Cancel(expectedDelivery.ID)
+		assert.Equal(t, hammer.ErrDeliveryIsNotPending, err)
+	})
+
+	t.Run("Test Cancel with delivery does not exists", func(t *testing.T) {
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("Cancel", txRepo, "delivery_id").Return(hammer.Delivery{}, sql.ErrNoRows)
+		deliveryRepo.On("Find", "delivery_id").Return(hammer.Delivery{}, sql.ErrNoRows)
+		txRepo.On("Rollback").Return(nil)
+
+		_, err := deliveryService.Cancel("delivery_id")
+		assert.Equal(t, hammer.ErrDeliveryDoesNotExists, err)
+	})
+
+	t.Run("Test CancelByMessage", func(t *testing.T) {
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryRepo.On("CancelByMessage", txRepo, "message_id").Return(2, nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveries, err := deliveryService.CancelByMessage("message_id")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, deliveries)
+	})
+
+	t.Run("Test Dispatch with success status codes", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAlreadyReported)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.SuccessStatusCodes = "200-299"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusCompleted, delivery.Status)
+		assert.True(t, deliveryAttempt.Success)
+		assert.Equal(t, http.StatusAlreadyReported, deliveryAttempt.ResponseStatusCode)
+	})
+
+	t.Run("Test Dispatch without following redirects", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/moved" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			http.Redirect(w, r, "/moved", http.StatusTemporaryRedirect)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.RedirectPolicy = hammer.RedirectPolicyNone
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
+		assert.False(t, deliveryAttempt.Success)
+		assert.Equal(t, http.StatusTemporaryRedirect, deliveryAttempt.ResponseStatusCode)
+	})
+
+	t.Run("Test Dispatch with permanent failure status codes", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "bad_request", http.StatusBadRequest)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.PermanentFailureStatusCodes = "400,422"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryScheduledAt := delivery.ScheduledAt
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, delivery.DeliveryAttempts)
+		assert.Equal(t, hammer.DeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, deliveryScheduledAt, delivery.ScheduledAt)
+		assert.False(t, deliveryAttempt.Success)
+	})
+
+	t.Run("Test Dispatch pauses subscription on gone", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "gone", http.StatusGone)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.PauseOnGone = true
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		subscriptionRepo.On("Pause", txRepo, delivery.SubscriptionID).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusGone, deliveryAttempt.ResponseStatusCode)
+		subscriptionRepo.AssertCalled(t, "Pause", txRepo, delivery.SubscriptionID)
+		subscriptionRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Dispatch with Retry-After", func(t *testing.T) {
+		countAttempts := hammer.RetryAfterCountAttempts
+		hammer.RetryAfterCountAttempts = false
+		defer func() { hammer.RetryAfterCountAttempts = countAttempts }()
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			http.Error(w, "too_many_requests", http.StatusTooManyRequests)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.DeliveryAttempts = delivery.MaxDeliveryAttempts - 1
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttemps := delivery.DeliveryAttempts
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, deliveryAttemps, delivery.DeliveryAttempts)
+		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
+		assert.WithinDuration(t, time.Now().UTC().Add(120*time.Second), delivery.ScheduledAt, 5*time.Second)
+		assert.Equal(t, http.StatusTooManyRequests, deliveryAttempt.ResponseStatusCode)
+	})
+
+	t.Run("Test Dispatch with Retry-After zero", func(t *testing.T) {
+		countAttempts := hammer.RetryAfterCountAttempts
+		hammer.RetryAfterCountAttempts = false
+		defer func() { hammer.RetryAfterCountAttempts = countAttempts }()
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "too_many_requests", http.StatusTooManyRequests)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.DeliveryAttempts = 1
+		delivery.DeliveryAttemptDelay = 60
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttemps := delivery.DeliveryAttempts
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, deliveryAttemps, delivery.DeliveryAttempts)
+		assert.Equal(t, hammer.DeliveryStatusPending, delivery.Status)
+		assert.Equal(t, 1, delivery.ThrottledAttempts)
+		assert.WithinDuration(t, time.Now().UTC().Add(60*time.Second), delivery.ScheduledAt, 5*time.Second)
+		assert.Equal(t, http.StatusTooManyRequests, deliveryAttempt.ResponseStatusCode)
+	})
+
+	t.Run("Test Dispatch with Retry-After after max throttled attempts", func(t *testing.T) {
+		countAttempts := hammer.RetryAfterCountAttempts
+		hammer.RetryAfterCountAttempts = false
+		defer func() { hammer.RetryAfterCountAttempts = countAttempts }()
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			http.Error(w, "too_many_requests", http.StatusTooManyRequests)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.DeliveryAttempts = delivery.MaxDeliveryAttempts - 1
+		delivery.ThrottledAttempts = hammer.RetryAfterMaxThrottledAttempts
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttemps := delivery.DeliveryAttempts
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.Equal(t, deliveryAttemps+1, delivery.DeliveryAttempts)
+		assert.Equal(t, hammer.DeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, http.StatusTooManyRequests, deliveryAttempt.ResponseStatusCode)
+	})
+
+	t.Run("Test Dispatch with headers and method", func(t *testing.T) {
+		httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Tenant-Id") != "tenant" {
+				http.Error(w, "bad_request", http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer httpServer.Close()
+		delivery := hammer.MakeTestDelivery()
+		delivery.URL = httpServer.URL
+		delivery.HTTPMethod = http.MethodPut
+		delivery.Headers = hammer.StringMap{"Authorization": "Bearer secret", "X-Tenant-Id": "tenant"}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		deliveryAttemptRepo := &mocks.DeliveryAttemptRepository{}
+		circuitBreakerRepo := &mocks.CircuitBreakerRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		deliveryService := NewDelivery(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, deliveryAttemptRepo, circuitBreakerRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		deliveryAttemptRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("FindForUpdate", mock.Anything, mock.Anything).Return(delivery, nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		circuitBreakerRepo.On("RecordSuccess", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		deliveryAttempt, err := deliveryService.Dispatch(context.Background(), &delivery, httpServer.Client())
+		assert.Nil(t, err)
+		assert.True(t, deliveryAttempt.Success)
+		assert.Contains(t, deliveryAttempt.Request, "PUT / HTTP/1.1")
+		assert.Contains(t, deliveryAttempt.Request, "Authorization: [REDACTED]")
+		assert.Contains(t, deliveryAttempt.Request, "X-Tenant-Id: tenant")
+		assert.NotContains(t, deliveryAttempt.Request, "Bearer secret")
+	})
 }
@@ -3,9 +3,15 @@ package service
 import (
 	"database/sql"
 	b64 "encoding/base64"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/allisson/hammer"
+	"github.com/allisson/hammer/filter"
+	"github.com/allisson/hammer/schema"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go.uber.org/zap"
 )
 
 // Message is a implementation of hammer.MessageService
@@ -17,6 +23,32 @@ type Message struct {
 	txFactoryRepo    hammer.TxFactoryRepository
 }
 
+// filterDocument returns the document used to evaluate the subscription filter expressions
+func filterDocument(message *hammer.Message) map[string]interface{} {
+	document := map[string]interface{}{
+		"topic_id":     message.TopicID,
+		"content_type": message.ContentType,
+		"attributes":   map[string]string(message.Attributes),
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(message.Data), &data); err == nil {
+		document["data"] = data
+	}
+	return document
+}
+
+func matchFilter(subscription *hammer.Subscription, document map[string]interface{}) bool {
+	if strings.TrimSpace(subscription.FilterExpression) == "" {
+		return true
+	}
+	expression, err := filter.Parse(subscription.FilterExpression)
+	if err != nil {
+		logger.Error("message-subscription-filter-parse", zap.String("subscription_id", subscription.ID), zap.Error(err))
+		return false
+	}
+	return expression.Match(document)
+}
+
 // Find returns hammer.Message by id
 func (m *Message) Find(id string) (hammer.Message, error) {
 	return m.messageRepo.Find(id)
@@ -27,10 +59,150 @@ func (m *Message) FindAll(findOptions hammer.FindOptions) ([]hammer.Message, err
 	return m.messageRepo.FindAll(findOptions)
 }
 
+// topicSchema returns the parsed schema of the topic, nil when the topic has no schema
+func topicSchema(topic *hammer.Topic) (*schema.Schema, error) {
+	if strings.TrimSpace(topic.Schema) == "" {
+		return nil, nil
+	}
+	return schema.Parse(topic.Schema)
+}
+
+// findSubscriptions returns the subscriptions of the topic
+func findSubscriptions(subscriptionRepo hammer.SubscriptionRepository, topicID string) ([]hammer.Subscription, error) {
+	findOptions := hammer.FindOptions{
+		FindFilters: []hammer.FindFilter{
+			{
+				FieldName: "topic_id",
+				Operator:  "=",
+				Value:     topicID,
+			},
+		},
+	}
+	return subscriptionRepo.FindAll(findOptions)
+}
+
+// prepareMessage sets the message id, created_at, the event attributes of the topic and encodes the data,
+// it returns the filter document of the message
+func prepareMessage(message *hammer.Message, topic *hammer.Topic) (map[string]interface{}, error) {
+	id, err := generateULID()
+	if err != nil {
+		return nil, err
+	}
+	message.ID = id
+	message.CreatedAt = time.Now().UTC()
+	if message.EventType == "" {
+		message.EventType = topic.EventType
+	}
+	message.EventSource = topic.EventSource
+	document := filterDocument(message)
+	message.Data = b64.StdEncoding.EncodeToString([]byte(message.Data))
+	return document, nil
+}
+
+// makeDeliveries returns the deliveries of message for each subscription that matches the document
+func makeDeliveries(message *hammer.Message, subscriptions []hammer.Subscription, document map[string]interface{}) ([]hammer.Delivery, error) {
+	deliveries := []hammer.Delivery{}
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		if !matchFilter(subscription, document) {
+			continue
+		}
+		id, err := generateULID()
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now().UTC()
+		delivery := hammer.Delivery{
+			ID:                          id,
+			TopicID:                     message.TopicID,
+			SubscriptionID:              subscription.ID,
+			MessageID:                   message.ID,
+			ContentType:                 message.ContentType,
+			Data:                        message.Data,
+			URL:                         subscription.URL,
+			SecretToken:                 subscription.SecretToken,
+			SigningMode:                 subscription.SigningMode,
+			MaxDeliveryAttempts:         subscription.MaxDeliveryAttempts,
+			DeliveryAttemptDelay:        subscription.DeliveryAttemptDelay,
+			DeliveryAttemptTimeout:      subscription.DeliveryAttemptTimeout,
+			RetryPolicy:                 subscription.RetryPolicy,
+			RetryMaxDelay:               subscription.RetryMaxDelay,
+			RetryJitter:                 subscription.RetryJitter,
+			Attributes:                  message.Attributes,
+			DeadLetterTopicID:           subscription.DeadLetterTopicID,
+			OrderingKey:                 message.OrderingKey,
+			SuccessStatusCodes:          subscription.SuccessStatusCodes,
+			RedirectPolicy:              subscription.RedirectPolicy,
+			PermanentFailureStatusCodes: subscription.PermanentFailureStatusCodes,
+			PauseOnGone:                 subscription.PauseOnGone,
+			Headers:                     subscription.Headers,
+			HTTPMethod:                  subscription.HTTPMethod,
+			PayloadFormat:               subscription.PayloadFormat,
+			TransformTemplate:           subscription.TransformTemplate,
+			TransformContentType:        subscription.TransformContentType,
+			EventType:                   message.EventType,
+			EventSource:                 message.EventSource,
+			ScheduledAt:                 now,
+			Status:                      hammer.DeliveryStatusPending,
+			CreatedAt:                   now,
+			UpdatedAt:                   now,
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// findByIdempotencyKey returns the message of the topic with the idempotency key
+func (m *Message) findByIdempotencyKey(topicID, idempotencyKey string) (hammer.Message, bool, error) {
+	findOptions := hammer.FindOptions{
+		FindFilters: []hammer.FindFilter{
+			{
+				FieldName: "topic_id",
+				Operator:  "=",
+				Value:     topicID,
+			},
+			{
+				FieldName: "idempotency_key",
+				Operator:  "=",
+				Value:     idempotencyKey,
+			},
+		},
+	}
+	messages, err := m.messageRepo.FindAll(findOptions)
+	if err != nil || len(messages) == 0 {
+		return hammer.Message{}, false, err
+	}
+	return messages[0], true, nil
+}
+
+// findOriginal replaces message with the message already created with the same idempotency key
+func (m *Message) findOriginal(message *hammer.Message) error {
+	original, found, err := m.findByIdempotencyKey(message.TopicID, message.IdempotencyKey)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return hammer.ErrMessageIdempotencyKeyConflict
+	}
+	*message = original
+	return nil
+}
+
+func idempotencyKeyExpired(message *hammer.Message) bool {
+	return time.Since(message.CreatedAt) > time.Duration(hammer.IdempotencyKeyRetention)*time.Second
+}
+
+// copyDuplicates replaces the messages with a repeated idempotency key on the batch with the first message
+func copyDuplicates(messages []*hammer.Message, duplicates map[int]int) {
+	for i, j := range duplicates {
+		*messages[i] = *messages[j]
+	}
+}
+
 // Create a hammer.Message on repository
 func (m *Message) Create(message *hammer.Message) error {
 	// Verify if topic already exists
-	_, err := m.topicRepo.Find(message.TopicID)
+	topic, err := m.topicRepo.Find(message.TopicID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return hammer.ErrTopicDoesNotExists
@@ -38,70 +210,88 @@ func (m *Message) Create(message *hammer.Message) error {
 		return err
 	}
 
+	// Validate data against the topic schema
+	s, err := topicSchema(&topic)
+	if err != nil {
+		return err
+	}
+	if s != nil {
+		if err := message.ValidateSchema(s); err != nil {
+			return err
+		}
+	}
+
+	// Verify idempotency key, a repeated key returns the original message
+	var expiredMessage *hammer.Message
+	if message.IdempotencyKey != "" {
+		original, found, err := m.findByIdempotencyKey(message.TopicID, message.IdempotencyKey)
+		if err != nil {
+			return err
+		}
+		if found {
+			if !idempotencyKeyExpired(&original) {
+				*message = original
+				return nil
+			}
+			expiredMessage = &original
+		}
+	}
+
 	// Start tx
 	tx, err := m.txFactoryRepo.New()
 	if err != nil {
 		return err
 	}
 
+	// Release expired idempotency key
+	if expiredMessage != nil {
+		expiredMessage.IdempotencyKey = ""
+		err = m.messageRepo.Store(tx, expiredMessage)
+		if err != nil {
+			rollback(tx, "message-release-idempotency-key")
+			return err
+		}
+	}
+
 	// Create message
-	id, err := generateULID()
+	document, err := prepareMessage(message, &topic)
 	if err != nil {
+		rollback(tx, "message-generate-id")
 		return err
 	}
-	now := time.Now().UTC()
-	message.ID = id
-	message.CreatedAt = now
-	message.Data = b64.StdEncoding.EncodeToString([]byte(message.Data))
 	err = m.messageRepo.Store(tx, message)
 	if err != nil {
+		rollback(tx, "message-store")
+		if err == hammer.ErrMessageIdempotencyKeyConflict {
+			return m.findOriginal(message)
+		}
 		return err
 	}
 
 	// Get subscriptions
-	findOptions := hammer.FindOptions{
-		FindFilters: []hammer.FindFilter{
-			{
-				FieldName: "topic_id",
-				Operator:  "=",
-				Value:     message.TopicID,
-			},
-		},
-	}
-	subscriptions, err := m.subscriptionRepo.FindAll(findOptions)
+	subscriptions, err := findSubscriptions(m.subscriptionRepo, message.TopicID)
 	if err != nil {
 		rollback(tx, "message-get-subscriptions")
 		return err
 	}
 
 	// Create deliveries
-	for _, subscription := range subscriptions {
-		id, err := generateULID()
+	deliveries, err := makeDeliveries(message, subscriptions, document)
+	if err != nil {
+		rollback(tx, "message-subscription-generate-id")
+		return err
+	}
+	for i := range deliveries {
+		err = m.deliveryRepo.Store(tx, &deliveries[i])
 		if err != nil {
-			rollback(tx, "message-subscription-generate-id")
+			rollback(tx, "message-delivery-create-rollback")
 			return err
 		}
-		now := time.Now().UTC()
-		delivery := hammer.Delivery{
-			ID:                     id,
-			TopicID:                message.TopicID,
-			SubscriptionID:         subscription.ID,
-			MessageID:              message.ID,
-			ContentType:            message.ContentType,
-			Data:                   message.Data,
-			URL:                    subscription.URL,
-			SecretToken:            subscription.SecretToken,
-			MaxDeliveryAttempts:    subscription.MaxDeliveryAttempts,
-			DeliveryAttemptDelay:   subscription.DeliveryAttemptDelay,
-			DeliveryAttemptTimeout: subscription.DeliveryAttemptTimeout,
-			ScheduledAt:            now,
-			Status:                 hammer.DeliveryStatusPending,
-			CreatedAt:              now,
-			UpdatedAt:              now,
-		}
-		err = m.deliveryRepo.Store(tx, &delivery)
+	}
+	if len(deliveries) > 0 {
+		err = m.deliveryRepo.Notify(tx)
 		if err != nil {
-			rollback(tx, "message-delivery-create-rollback")
+			rollback(tx, "message-delivery-notify")
 			return err
 		}
 	}
@@ -116,6 +306,213 @@ func (m *Message) Create(message *hammer.Message) error {
 	return nil
 }
 
+// CreateBatch creates the messages on repository using one transaction, the subscriptions are resolved once per topic.
+// The returned slice has an error for each message that could not be created (nil on success),
+// the second return value is used for errors that abort the whole batch.
+func (m *Message) CreateBatch(messages []*hammer.Message) ([]error, error) {
+	errs := make([]error, len(messages))
+
+	// Get subscriptions for each topic
+	topics := make(map[string]hammer.Topic)
+	topicSchemas := make(map[string]*schema.Schema)
+	topicSubscriptions := make(map[string][]hammer.Subscription)
+	topicErrors := make(map[string]error)
+	for _, message := range messages {
+		if _, ok := topicSubscriptions[message.TopicID]; ok {
+			continue
+		}
+		if _, ok := topicErrors[message.TopicID]; ok {
+			continue
+		}
+		topic, err := m.topicRepo.Find(message.TopicID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				topicErrors[message.TopicID] = hammer.ErrTopicDoesNotExists
+				continue
+			}
+			return errs, err
+		}
+		topics[message.TopicID] = topic
+		topicSchemas[message.TopicID], err = topicSchema(&topic)
+		if err != nil {
+			return errs, err
+		}
+		subscriptions, err := findSubscriptions(m.subscriptionRepo, message.TopicID)
+		if err != nil {
+			return errs, err
+		}
+		topicSubscriptions[message.TopicID] = subscriptions
+	}
+
+	// Prepare messages and deliveries
+	newMessages := []hammer.Message{}
+	newIndexes := []int{}
+	newDeliveries := []hammer.Delivery{}
+	expiredMessages := []hammer.Message{}
+	duplicates := make(map[int]int)
+	idempotencyKeys := make(map[string]int)
+	for i, message := range messages {
+		if err, ok := topicErrors[message.TopicID]; ok {
+			errs[i] = err
+			continue
+		}
+		if s := topicSchemas[message.TopicID]; s != nil {
+			if err := message.ValidateSchema(s); err != nil {
+				if _, ok := err.(validation.Errors); !ok {
+					return errs, err
+				}
+				errs[i] = err
+				continue
+			}
+		}
+		if message.IdempotencyKey != "" {
+			// Repeated key on the same batch
+			key := message.TopicID + "/" + message.IdempotencyKey
+			if j, ok := idempotencyKeys[key]; ok {
+				duplicates[i] = j
+				continue
+			}
+			idempotencyKeys[key] = i
+
+			// Repeated key on repository
+			original, found, err := m.findByIdempotencyKey(message.TopicID, message.IdempotencyKey)
+			if err != nil {
+				return errs, err
+			}
+			if found {
+				if !idempotencyKeyExpired(&original) {
+					*message = original
+					continue
+				}
+				expiredMessages = append(expiredMessages, original)
+			}
+		}
+		topic := topics[message.TopicID]
+		document, err := prepareMessage(message, &topic)
+		if err != nil {
+			return errs, err
+		}
+		deliveries, err := makeDeliveries(message, topicSubscriptions[message.TopicID], document)
+		if err != nil {
+			return errs, err
+		}
+		newMessages = append(newMessages, *message)
+		newIndexes = append(newIndexes, i)
+		newDeliveries = append(newDeliveries, deliveries...)
+	}
+
+	// Create messages and deliveries, a message with an idempotency key created by a concurrent request
+	// is reported on errs and the batch is created again without it
+	for len(newMessages) > 0 {
+		err := m.storeBatch(expiredMessages, newMessages, newDeliveries)
+		if err != hammer.ErrMessageIdempotencyKeyConflict {
+			if err != nil {
+				return errs, err
+			}
+			break
+		}
+		conflicts, err := m.findConflicts(newMessages, expiredMessages)
+		if err != nil {
+			return errs, err
+		}
+		if len(conflicts) == 0 {
+			return errs, hammer.ErrMessageIdempotencyKeyConflict
+		}
+		messagesLeft := []hammer.Message{}
+		indexesLeft := []int{}
+		for k := range newMessages {
+			if conflicts[newMessages[k].ID] {
+				errs[newIndexes[k]] = hammer.ErrMessageIdempotencyKeyConflict
+				continue
+			}
+			messagesLeft = append(messagesLeft, newMessages[k])
+			indexesLeft = append(indexesLeft, newIndexes[k])
+		}
+		deliveriesLeft := []hammer.Delivery{}
+		for k := range newDeliveries {
+			if !conflicts[newDeliveries[k].MessageID] {
+				deliveriesLeft = append(deliveriesLeft, newDeliveries[k])
+			}
+		}
+		newMessages, newIndexes, newDeliveries = messagesLeft, indexesLeft, deliveriesLeft
+	}
+	for i, j := range duplicates {
+		errs[i] = errs[j]
+	}
+	copyDuplicates(messages, duplicates)
+
+	return errs, nil
+}
+
+// findConflicts returns the ids of the messages whose idempotency key is already used by another message on repository
+func (m *Message) findConflicts(messages []hammer.Message, expiredMessages []hammer.Message) (map[string]bool, error) {
+	expired := make(map[string]bool)
+	for i := range expiredMessages {
+		expired[expiredMessages[i].ID] = true
+	}
+	conflicts := make(map[string]bool)
+	for i := range messages {
+		if messages[i].IdempotencyKey == "" {
+			continue
+		}
+		original, found, err := m.findByIdempotencyKey(messages[i].TopicID, messages[i].IdempotencyKey)
+		if err != nil {
+			return conflicts, err
+		}
+		if found && !expired[original.ID] {
+			conflicts[messages[i].ID] = true
+		}
+	}
+	return conflicts, nil
+}
+
+// storeBatch releases the expired idempotency keys and creates the messages and deliveries in one tx
+func (m *Message) storeBatch(expiredMessages []hammer.Message, newMessages []hammer.Message, newDeliveries []hammer.Delivery) error {
+	// Start tx
+	tx, err := m.txFactoryRepo.New()
+	if err != nil {
+		return err
+	}
+
+	// Release expired idempotency keys
+	for i := range expiredMessages {
+		expiredMessages[i].IdempotencyKey = ""
+		err = m.messageRepo.Store(tx, &expiredMessages[i])
+		if err != nil {
+			rollback(tx, "message-create-batch-release-idempotency-key")
+			return err
+		}
+	}
+
+	// Create messages and deliveries
+	err = m.messageRepo.CreateBatch(tx, newMessages)
+	if err != nil {
+		rollback(tx, "message-create-batch-messages")
+		return err
+	}
+	if len(newDeliveries) > 0 {
+		err = m.deliveryRepo.CreateBatch(tx, newDeliveries)
+		if err != nil {
+			rollback(tx, "message-create-batch-deliveries")
+			return err
+		}
+		err = m.deliveryRepo.Notify(tx)
+		if err != nil {
+			rollback(tx, "message-create-batch-notify")
+			return err
+		}
+	}
+
+	// tx Commit
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "message-create-batch-commit")
+		return err
+	}
+
+	return nil
+}
+
 // Delete a hammer.Message on repository
 func (m *Message) Delete(id string) error {
 	tx, err := m.txFactoryRepo.New()
@@ -3,9 +3,11 @@ package service
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/allisson/hammer"
 	"github.com/allisson/hammer/mocks"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -62,12 +64,456 @@ func TestMessage(t *testing.T) {
 		txFactoryRepo.On("New").Return(txRepo, nil)
 		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
 		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
 		txRepo.On("Commit").Return(nil)
 
 		err := messageService.Create(&message)
 		assert.Nil(t, err)
 		assert.NotEqual(t, "", message.ID)
 		assert.Equal(t, "eyJpZCI6ICJpZCIsICJuYW1lIjogIkFsbGlzc29uIn0=", message.Data)
+		deliveryRepo.AssertCalled(t, "Notify", txRepo)
+	})
+
+	t.Run("Test Create with subscription filter expression", func(t *testing.T) {
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		matchSubscription := hammer.MakeTestSubscription()
+		matchSubscription.FilterExpression = `data.name = "Allisson"`
+		noMatchSubscription := hammer.MakeTestSubscription()
+		noMatchSubscription.FilterExpression = `data.name = "Other"`
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{matchSubscription, noMatchSubscription}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Store", mock.Anything, mock.MatchedBy(func(delivery *hammer.Delivery) bool {
+			return delivery.SubscriptionID == matchSubscription.ID
+		})).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		deliveryRepo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("Test Create with subscription filter expression on attributes", func(t *testing.T) {
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		message.Attributes = hammer.StringMap{"region": "us"}
+		matchSubscription := hammer.MakeTestSubscription()
+		matchSubscription.FilterExpression = `data.name = "Allisson" AND attributes.region = "us"`
+		noMatchSubscription := hammer.MakeTestSubscription()
+		noMatchSubscription.FilterExpression = `attributes.region = "eu"`
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{matchSubscription, noMatchSubscription}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Store", mock.Anything, mock.MatchedBy(func(delivery *hammer.Delivery) bool {
+			return delivery.SubscriptionID == matchSubscription.ID && delivery.Attributes["region"] == "us"
+		})).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		deliveryRepo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("Test Create with blank subscription filter expression", func(t *testing.T) {
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		subscription := hammer.MakeTestSubscription()
+		subscription.FilterExpression = "  "
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{subscription}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		deliveryRepo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("Test Create with ordering key", func(t *testing.T) {
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		message.OrderingKey = "order-1"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{hammer.MakeTestSubscription()}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Store", mock.Anything, mock.MatchedBy(func(delivery *hammer.Delivery) bool {
+			return delivery.OrderingKey == "order-1"
+		})).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		deliveryRepo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("Test Create with topic event type", func(t *testing.T) {
+		message1 := hammer.MakeTestMessage()
+		message2 := hammer.MakeTestMessage()
+		message2.EventType = "com.example.order.paid"
+		topic := hammer.MakeTestTopic()
+		topic.EventType = "com.example.order.created"
+		topic.EventSource = "https://example.com/orders"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{hammer.MakeTestSubscription()}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message1)
+		assert.Nil(t, err)
+		assert.Equal(t, topic.EventType, message1.EventType)
+		assert.Equal(t, topic.EventSource, message1.EventSource)
+		deliveryRepo.AssertCalled(t, "Store", txRepo, mock.MatchedBy(func(d *hammer.Delivery) bool {
+			return d.MessageID == message1.ID && d.EventType == topic.EventType && d.EventSource == topic.EventSource
+		}))
+
+		err = messageService.Create(&message2)
+		assert.Nil(t, err)
+		assert.Equal(t, "com.example.order.paid", message2.EventType)
+		assert.Equal(t, topic.EventSource, message2.EventSource)
+	})
+
+	t.Run("Test Create with repeated idempotency key", func(t *testing.T) {
+		original := hammer.MakeTestMessage()
+		original.IdempotencyKey = "key"
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		message.TopicID = original.TopicID
+		message.IdempotencyKey = original.IdempotencyKey
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		messageRepo.On("FindAll", mock.Anything).Return([]hammer.Message{original}, nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		assert.Equal(t, original, message)
+		txFactoryRepo.AssertNotCalled(t, "New")
+	})
+
+	t.Run("Test Create with expired idempotency key", func(t *testing.T) {
+		original := hammer.MakeTestMessage()
+		original.IdempotencyKey = "key"
+		original.CreatedAt = time.Now().UTC().Add(-time.Duration(hammer.IdempotencyKeyRetention+60) * time.Second)
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		message.TopicID = original.TopicID
+		message.IdempotencyKey = original.IdempotencyKey
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		messageRepo.On("FindAll", mock.Anything).Return([]hammer.Message{original}, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.MatchedBy(func(m *hammer.Message) bool {
+			return m.ID == original.ID && m.IdempotencyKey == ""
+		})).Return(nil)
+		messageRepo.On("Store", mock.Anything, mock.MatchedBy(func(m *hammer.Message) bool {
+			return m.ID != original.ID && m.IdempotencyKey == "key"
+		})).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		assert.NotEqual(t, original.ID, message.ID)
+		messageRepo.AssertNumberOfCalls(t, "Store", 2)
+	})
+
+	t.Run("Test Create with idempotency key conflict", func(t *testing.T) {
+		original := hammer.MakeTestMessage()
+		original.IdempotencyKey = "key"
+		message := hammer.MakeTestMessage()
+		message.ID = ""
+		message.TopicID = original.TopicID
+		message.IdempotencyKey = original.IdempotencyKey
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(hammer.Topic{}, nil)
+		messageRepo.On("FindAll", mock.Anything).Return([]hammer.Message{}, nil).Once()
+		messageRepo.On("FindAll", mock.Anything).Return([]hammer.Message{original}, nil).Once()
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(hammer.ErrMessageIdempotencyKeyConflict)
+		txRepo.On("Rollback").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+		assert.Equal(t, original, message)
+		deliveryRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test CreateBatch with repeated idempotency keys", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		original := hammer.MakeTestMessage()
+		original.TopicID = topic.ID
+		original.IdempotencyKey = "key1"
+		message1 := hammer.MakeTestMessage()
+		message1.TopicID = topic.ID
+		message1.IdempotencyKey = "key1"
+		message2 := hammer.MakeTestMessage()
+		message2.TopicID = topic.ID
+		message2.IdempotencyKey = "key2"
+		message3 := hammer.MakeTestMessage()
+		message3.TopicID = topic.ID
+		message3.IdempotencyKey = "key2"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{hammer.MakeTestSubscription()}, nil)
+		messageRepo.On("FindAll", mock.Anything).Return([]hammer.Message{original}, nil).Once()
+		messageRepo.On("FindAll", mock.Anything).Return([]hammer.Message{}, nil).Once()
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(messages []hammer.Message) bool {
+			return len(messages) == 1
+		})).Return(nil)
+		deliveryRepo.On("CreateBatch", mock.Anything, mock.Anything).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		errs, err := messageService.CreateBatch([]*hammer.Message{&message1, &message2, &message3})
+		assert.Nil(t, err)
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		assert.Equal(t, original, message1)
+		assert.Equal(t, message2, message3)
+	})
+
+	t.Run("Test CreateBatch with idempotency key conflict", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		concurrent := hammer.MakeTestMessage()
+		concurrent.TopicID = topic.ID
+		concurrent.IdempotencyKey = "key1"
+		message1 := hammer.MakeTestMessage()
+		message1.TopicID = topic.ID
+		message1.IdempotencyKey = "key1"
+		message2 := hammer.MakeTestMessage()
+		message2.TopicID = topic.ID
+		message2.IdempotencyKey = "key2"
+		hasKey := func(key string) interface{} {
+			return mock.MatchedBy(func(findOptions hammer.FindOptions) bool {
+				for _, findFilter := range findOptions.FindFilters {
+					if findFilter.FieldName == "idempotency_key" && findFilter.Value == key {
+						return true
+					}
+				}
+				return false
+			})
+		}
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{hammer.MakeTestSubscription()}, nil)
+		messageRepo.On("FindAll", hasKey("key1")).Return([]hammer.Message{}, nil).Once()
+		messageRepo.On("FindAll", hasKey("key1")).Return([]hammer.Message{concurrent}, nil)
+		messageRepo.On("FindAll", hasKey("key2")).Return([]hammer.Message{}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(messages []hammer.Message) bool {
+			return len(messages) == 2
+		})).Return(hammer.ErrMessageIdempotencyKeyConflict)
+		messageRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(messages []hammer.Message) bool {
+			return len(messages) == 1 && messages[0].IdempotencyKey == "key2"
+		})).Return(nil)
+		deliveryRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(deliveries []hammer.Delivery) bool {
+			return len(deliveries) == 1 && deliveries[0].MessageID == message2.ID
+		})).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Rollback").Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		errs, err := messageService.CreateBatch([]*hammer.Message{&message1, &message2})
+		assert.Nil(t, err)
+		assert.Equal(t, []error{hammer.ErrMessageIdempotencyKeyConflict, nil}, errs)
+		txRepo.AssertNumberOfCalls(t, "Commit", 1)
+	})
+
+	t.Run("Test CreateBatch", func(t *testing.T) {
+		topic1 := hammer.MakeTestTopic()
+		topic2 := hammer.MakeTestTopic()
+		message1 := hammer.MakeTestMessage()
+		message1.TopicID = topic1.ID
+		message2 := hammer.MakeTestMessage()
+		message2.TopicID = topic1.ID
+		message3 := hammer.MakeTestMessage()
+		message3.TopicID = topic2.ID
+		subscription1 := hammer.MakeTestSubscription()
+		subscription1.TopicID = topic1.ID
+		subscription2 := hammer.MakeTestSubscription()
+		subscription2.TopicID = topic1.ID
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", topic1.ID).Return(topic1, nil)
+		topicRepo.On("Find", topic2.ID).Return(hammer.Topic{}, sql.ErrNoRows)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{subscription1, subscription2}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(messages []hammer.Message) bool {
+			return len(messages) == 2
+		})).Return(nil)
+		deliveryRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(deliveries []hammer.Delivery) bool {
+			return len(deliveries) == 4
+		})).Return(nil)
+		deliveryRepo.On("Notify", mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		errs, err := messageService.CreateBatch([]*hammer.Message{&message1, &message2, &message3})
+		assert.Nil(t, err)
+		assert.Equal(t, []error{nil, nil, hammer.ErrTopicDoesNotExists}, errs)
+		assert.NotEqual(t, "", message1.ID)
+		assert.NotEqual(t, message1.ID, message2.ID)
+		assert.Equal(t, "eyJpZCI6ICJpZCIsICJuYW1lIjogIkFsbGlzc29uIn0=", message1.Data)
+		subscriptionRepo.AssertNumberOfCalls(t, "FindAll", 1)
+		txFactoryRepo.AssertNumberOfCalls(t, "New", 1)
+	})
+
+	t.Run("Test Create with topic schema violation", func(t *testing.T) {
+		message := hammer.MakeTestMessage()
+		message.Data = `{"id": 1}`
+		topic := hammer.MakeTestTopic()
+		topic.Schema = `{"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id", "name"]}`
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+
+		err := messageService.Create(&message)
+		errs, ok := err.(validation.Errors)
+		assert.True(t, ok)
+		assert.Contains(t, errs, "data.id")
+		assert.Contains(t, errs, "data.name")
+		txFactoryRepo.AssertNotCalled(t, "New")
+	})
+
+	t.Run("Test Create with blank topic schema", func(t *testing.T) {
+		message := hammer.MakeTestMessage()
+		message.Data = "Allisson"
+		topic := hammer.MakeTestTopic()
+		topic.Schema = "  "
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := messageService.Create(&message)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test CreateBatch with topic schema violation", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		topic.Schema = `{"type": "object", "required": ["id"]}`
+		message1 := hammer.MakeTestMessage()
+		message1.TopicID = topic.ID
+		message2 := hammer.MakeTestMessage()
+		message2.TopicID = topic.ID
+		message2.Data = `{"name": "Allisson"}`
+		message3 := hammer.MakeTestMessage()
+		message3.TopicID = topic.ID
+		message3.Data = "Allisson"
+		topicRepo := &mocks.TopicRepository{}
+		messageRepo := &mocks.MessageRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		deliveryRepo := &mocks.DeliveryRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		messageService := NewMessage(topicRepo, messageRepo, subscriptionRepo, deliveryRepo, txFactoryRepo)
+		topicRepo.On("Find", topic.ID).Return(topic, nil)
+		subscriptionRepo.On("FindAll", mock.Anything).Return([]hammer.Subscription{}, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		messageRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(messages []hammer.Message) bool {
+			return len(messages) == 1
+		})).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		errs, err := messageService.CreateBatch([]*hammer.Message{&message1, &message2, &message3})
+		assert.Nil(t, err)
+		assert.Nil(t, errs[0])
+		assert.Contains(t, errs[1], "data.id")
+		assert.Contains(t, errs[2], "data")
+		messageRepo.AssertExpectations(t)
 	})
 
 	t.Run("Test Create with topic does not exists on repository", func(t *testing.T) {
@@ -0,0 +1,133 @@
+package service
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/allisson/hammer"
+)
+
+// payload represents the body and headers of a delivery request
+type payload struct {
+	Body   []byte
+	Header http.Header
+}
+
+// isJSONContentType returns true for application/json and the +json media types
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// cloudEvent returns the CloudEvents attributes of the delivery
+func cloudEvent(delivery *hammer.Delivery) hammer.CloudEventPayload {
+	cloudEvent := hammer.CloudEventPayload{
+		SpecVersion:     "1.0",
+		Type:            delivery.EventType,
+		Source:          delivery.EventSource,
+		ID:              delivery.ID,
+		Time:            delivery.CreatedAt,
+		MessageID:       delivery.MessageID,
+		SubscriptionID:  delivery.SubscriptionID,
+		TopicID:         delivery.TopicID,
+		DataContentType: delivery.ContentType,
+		Extensions:      delivery.Attributes,
+	}
+	if cloudEvent.Type == "" {
+		cloudEvent.Type = hammer.DefaultEventType
+	}
+	if cloudEvent.Source == "" {
+		cloudEvent.Source = fmt.Sprintf("/v1/messages/%s", delivery.MessageID)
+	}
+	if delivery.SigningMode == hammer.SigningModeLegacy {
+		cloudEvent.SecretToken = delivery.SecretToken
+	}
+	return cloudEvent
+}
+
+// makePayload returns the delivery request payload using the delivery payload format
+func makePayload(delivery *hammer.Delivery) (payload, error) {
+	switch delivery.PayloadFormat {
+	case hammer.PayloadFormatBinary:
+		return makeBinaryPayload(delivery)
+	case hammer.PayloadFormatRaw:
+		return makeRawPayload(delivery)
+	default:
+		return makeStructuredPayload(delivery)
+	}
+}
+
+// makeStructuredPayload returns the CloudEvents structured mode payload, the data is sent inline
+// only with the structured_data format and a valid json data
+func makeStructuredPayload(delivery *hammer.Delivery) (payload, error) {
+	event := cloudEvent(delivery)
+	event.DataBase64 = delivery.Data
+	if delivery.PayloadFormat == hammer.PayloadFormatStructuredData && isJSONContentType(delivery.ContentType) {
+		data, err := b64.StdEncoding.DecodeString(delivery.Data)
+		if err == nil && json.Valid(data) {
+			event.Data = data
+			event.DataBase64 = ""
+		}
+	}
+
+	body, err := json.Marshal(&event)
+	if err != nil {
+		return payload{}, err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	return payload{Body: body, Header: header}, nil
+}
+
+// makeBinaryPayload returns the CloudEvents binary mode payload, the data is sent as body and the attributes as ce-* headers
+func makeBinaryPayload(delivery *hammer.Delivery) (payload, error) {
+	event := cloudEvent(delivery)
+	body, err := b64.StdEncoding.DecodeString(delivery.Data)
+	if err != nil {
+		return payload{}, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", event.DataContentType)
+	header.Set("ce-specversion", event.SpecVersion)
+	header.Set("ce-type", event.Type)
+	header.Set("ce-source", event.Source)
+	header.Set("ce-id", event.ID)
+	header.Set("ce-time", event.Time.Format(time.RFC3339Nano))
+	header.Set("ce-messageid", event.MessageID)
+	header.Set("ce-subscriptionid", event.SubscriptionID)
+	header.Set("ce-topicid", event.TopicID)
+	if event.SecretToken != "" {
+		header.Set("ce-secrettoken", event.SecretToken)
+	}
+	for name, value := range event.Extensions {
+		header.Set("ce-"+name, value)
+	}
+
+	return payload{Body: body, Header: header}, nil
+}
+
+// makeRawPayload returns the message data as body and the delivery metadata as X-Hammer-* headers
+func makeRawPayload(delivery *hammer.Delivery) (payload, error) {
+	body, err := b64.StdEncoding.DecodeString(delivery.Data)
+	if err != nil {
+		return payload{}, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", delivery.ContentType)
+	header.Set("X-Hammer-Delivery-Id", delivery.ID)
+	header.Set("X-Hammer-Message-Id", delivery.MessageID)
+	header.Set("X-Hammer-Attempt", strconv.Itoa(delivery.DeliveryAttempts+1))
+
+	return payload{Body: body, Header: header}, nil
+}
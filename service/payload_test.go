@@ -0,0 +1,102 @@
+package service
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/allisson/hammer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakePayload(t *testing.T) {
+	t.Run("Test structured format", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte(`{"name":"Allisson"}`))
+
+		p, err := makePayload(&delivery)
+		assert.Nil(t, err)
+		assert.Equal(t, "application/json", p.Header.Get("Content-Type"))
+		body := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal(p.Body, &body))
+		assert.Equal(t, delivery.Data, body["data_base64"])
+		assert.NotContains(t, body, "data")
+	})
+
+	t.Run("Test structured_data format", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.PayloadFormat = hammer.PayloadFormatStructuredData
+		delivery.ContentType = "application/vnd.api+json"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte(`{"name":"Allisson"}`))
+
+		p, err := makePayload(&delivery)
+		assert.Nil(t, err)
+		body := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal(p.Body, &body))
+		assert.Equal(t, map[string]interface{}{"name": "Allisson"}, body["data"])
+		assert.NotContains(t, body, "data_base64")
+	})
+
+	t.Run("Test structured_data format without json data", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.PayloadFormat = hammer.PayloadFormatStructuredData
+		delivery.ContentType = "text/plain"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte("Allisson"))
+
+		p, err := makePayload(&delivery)
+		assert.Nil(t, err)
+		body := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal(p.Body, &body))
+		assert.Equal(t, delivery.Data, body["data_base64"])
+		assert.NotContains(t, body, "data")
+	})
+
+	t.Run("Test binary format", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.PayloadFormat = hammer.PayloadFormatBinary
+		delivery.ContentType = "text/plain"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte("Allisson"))
+		delivery.Attributes = hammer.StringMap{"traceid": "trace"}
+
+		p, err := makePayload(&delivery)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("Allisson"), p.Body)
+		assert.Equal(t, "text/plain", p.Header.Get("Content-Type"))
+		assert.Equal(t, "1.0", p.Header.Get("ce-specversion"))
+		assert.Equal(t, delivery.ID, p.Header.Get("ce-id"))
+		assert.Equal(t, delivery.MessageID, p.Header.Get("ce-messageid"))
+		assert.Equal(t, "trace", p.Header.Get("ce-traceid"))
+		assert.Equal(t, "", p.Header.Get("ce-secrettoken"))
+	})
+
+	t.Run("Test raw format", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.PayloadFormat = hammer.PayloadFormatRaw
+		delivery.ContentType = "text/plain"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte("Allisson"))
+		delivery.DeliveryAttempts = 2
+
+		p, err := makePayload(&delivery)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("Allisson"), p.Body)
+		assert.Equal(t, "text/plain", p.Header.Get("Content-Type"))
+		assert.Equal(t, delivery.ID, p.Header.Get("X-Hammer-Delivery-Id"))
+		assert.Equal(t, delivery.MessageID, p.Header.Get("X-Hammer-Message-Id"))
+		assert.Equal(t, "3", p.Header.Get("X-Hammer-Attempt"))
+		assert.Equal(t, "", p.Header.Get("ce-id"))
+	})
+
+	t.Run("Test event type and source", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.MessageID = "message"
+		event := cloudEvent(&delivery)
+		assert.Equal(t, hammer.DefaultEventType, event.Type)
+		assert.Equal(t, "/v1/messages/message", event.Source)
+
+		delivery.EventType = "com.example.order.created"
+		delivery.EventSource = "https://example.com/orders"
+		event = cloudEvent(&delivery)
+		assert.Equal(t, "com.example.order.created", event.Type)
+		assert.Equal(t, "https://example.com/orders", event.Source)
+	})
+}
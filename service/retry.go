@@ -0,0 +1,78 @@
+package service
+
+import (
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/allisson/hammer"
+)
+
+// retryDelay returns the delay before the next attempt using the delivery retry policy
+func retryDelay(delivery *hammer.Delivery) time.Duration {
+	base := float64(delivery.DeliveryAttemptDelay)
+	attempt := delivery.DeliveryAttempts
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	// Calculate delay in seconds
+	delay := base
+	switch delivery.RetryPolicy {
+	case hammer.RetryPolicyLinear:
+		delay = base * float64(attempt)
+	case hammer.RetryPolicyExponential:
+		delay = base * math.Pow(2, float64(attempt-1))
+	}
+
+	// Apply max delay
+	if delivery.RetryMaxDelay > 0 && delay > float64(delivery.RetryMaxDelay) {
+		delay = float64(delivery.RetryMaxDelay)
+	}
+	if delay > math.MaxInt32 {
+		delay = math.MaxInt32
+	}
+
+	// Apply jitter, the result is between half and the full delay
+	duration := time.Duration(delay * float64(time.Second))
+	if delivery.RetryJitter && duration > 1 {
+		half := duration / 2
+		duration = half + time.Duration(mathrand.Int63n(int64(half)))
+	}
+
+	return duration
+}
+
+// parseRetryAfter returns the delay of a Retry-After header value in seconds or HTTP-date format,
+// the delay is capped by hammer.RetryAfterMaxDelay
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	// Calculate delay in seconds
+	var delay float64
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		delay = float64(seconds)
+	} else {
+		date, err := http.ParseTime(value)
+		if err != nil {
+			return 0, false
+		}
+		delay = math.Max(0, date.Sub(now).Seconds())
+	}
+
+	// Apply max delay
+	if delay > float64(hammer.RetryAfterMaxDelay) {
+		delay = float64(hammer.RetryAfterMaxDelay)
+	}
+
+	return time.Duration(delay * float64(time.Second)), true
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/allisson/hammer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		retryPolicy      string
+		retryMaxDelay    int
+		deliveryAttempts int
+		expected         time.Duration
+	}{
+		{"", 0, 3, 60 * time.Second},
+		{hammer.RetryPolicyFixed, 0, 1, 60 * time.Second},
+		{hammer.RetryPolicyFixed, 0, 3, 60 * time.Second},
+		{hammer.RetryPolicyLinear, 0, 1, 60 * time.Second},
+		{hammer.RetryPolicyLinear, 0, 3, 180 * time.Second},
+		{hammer.RetryPolicyLinear, 120, 3, 120 * time.Second},
+		{hammer.RetryPolicyExponential, 0, 1, 60 * time.Second},
+		{hammer.RetryPolicyExponential, 0, 2, 120 * time.Second},
+		{hammer.RetryPolicyExponential, 0, 4, 480 * time.Second},
+		{hammer.RetryPolicyExponential, 300, 4, 300 * time.Second},
+		{hammer.RetryPolicyExponential, 0, 1000, 2147483647 * time.Second},
+	}
+
+	for _, tt := range tests {
+		delivery := hammer.MakeTestDelivery()
+		delivery.RetryPolicy = tt.retryPolicy
+		delivery.RetryMaxDelay = tt.retryMaxDelay
+		delivery.DeliveryAttempts = tt.deliveryAttempts
+		assert.Equal(t, tt.expected, retryDelay(&delivery))
+	}
+}
+
+func TestRetryDelayWithJitter(t *testing.T) {
+	delivery := hammer.MakeTestDelivery()
+	delivery.RetryPolicy = hammer.RetryPolicyExponential
+	delivery.RetryJitter = true
+	delivery.DeliveryAttempts = 2
+
+	for i := 0; i < 100; i++ {
+		delay := retryDelay(&delivery)
+		assert.True(t, delay >= 60*time.Second)
+		assert.True(t, delay < 120*time.Second)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 5, 17, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"invalid", 0, false},
+		{"-1", 0, false},
+		{"0", 0, true},
+		{"120", 120 * time.Second, true},
+		{"86400", 3600 * time.Second, true},
+		{"Sun, 17 May 2020 18:05:00 GMT", 300 * time.Second, true},
+		{"Sun, 17 May 2020 17:55:00 GMT", 0, true},
+		{"Mon, 18 May 2020 18:00:00 GMT", 3600 * time.Second, true},
+	}
+
+	for _, tt := range tests {
+		delay, ok := parseRetryAfter(tt.value, now)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		assert.Equal(t, tt.expected, delay, tt.value)
+	}
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"database/sql"
+	b64 "encoding/base64"
+	"net/http"
 	"time"
 
 	"github.com/allisson/hammer"
@@ -25,6 +27,64 @@ func (s *Subscription) topicExists(topicID string) error {
 	return nil
 }
 
+func (s *Subscription) deadLetterTopicExists(topicID string) error {
+	if topicID == "" {
+		return nil
+	}
+	err := s.topicExists(topicID)
+	if err == hammer.ErrTopicDoesNotExists {
+		return hammer.ErrDeadLetterTopicDoesNotExists
+	}
+	return err
+}
+
+// deadLetterTopicCycle follows the dead-letter topics of the subscriptions starting on the subscription dead-letter topic,
+// the failed deliveries would be published forever if the subscription topic is reached
+func (s *Subscription) deadLetterTopicCycle(subscription *hammer.Subscription) error {
+	if subscription.DeadLetterTopicID == "" {
+		return nil
+	}
+	visited := make(map[string]bool)
+	topicIDs := []string{subscription.DeadLetterTopicID}
+	for len(topicIDs) > 0 {
+		topicID := topicIDs[0]
+		topicIDs = topicIDs[1:]
+		if topicID == subscription.TopicID {
+			return hammer.ErrDeadLetterTopicCycle
+		}
+		if visited[topicID] {
+			continue
+		}
+		visited[topicID] = true
+		subscriptions, err := findSubscriptions(s.subscriptionRepo, topicID)
+		if err != nil {
+			return err
+		}
+		for i := range subscriptions {
+			// The stored dead-letter topic of the subscription is replaced by the informed one
+			if subscriptions[i].ID == subscription.ID || subscriptions[i].DeadLetterTopicID == "" {
+				continue
+			}
+			topicIDs = append(topicIDs, subscriptions[i].DeadLetterTopicID)
+		}
+	}
+	return nil
+}
+
+// mergeHeaders keeps the stored headers when headers are not informed and the stored value of the
+// headers informed with the redacted value returned on responses
+func mergeHeaders(headers, storedHeaders hammer.StringMap) hammer.StringMap {
+	if headers == nil {
+		return storedHeaders
+	}
+	for name, value := range headers {
+		if storedValue, ok := storedHeaders[name]; ok && value == hammer.RedactedHeaderValue {
+			headers[name] = storedValue
+		}
+	}
+	return headers
+}
+
 // Find returns hammer.Subscription by id
 func (s *Subscription) Find(id string) (hammer.Subscription, error) {
 	return s.subscriptionRepo.Find(id)
@@ -49,6 +109,16 @@ func (s *Subscription) Create(subscription *hammer.Subscription) error {
 		return err
 	}
 
+	// Verify if dead-letter topic already exists
+	err = s.deadLetterTopicExists(subscription.DeadLetterTopicID)
+	if err != nil {
+		return err
+	}
+	err = s.deadLetterTopicCycle(subscription)
+	if err != nil {
+		return err
+	}
+
 	// Create new subscription with default values
 	tx, err := s.txFactoryRepo.New()
 	if err != nil {
@@ -60,6 +130,27 @@ func (s *Subscription) Create(subscription *hammer.Subscription) error {
 	if subscription.SecretToken == "" {
 		subscription.SecretToken = generateRandomString(hammer.DefaultSecretTokenLength)
 	}
+	if subscription.SigningMode == "" {
+		subscription.SigningMode = hammer.SigningModeHMAC
+	}
+	if subscription.RetryPolicy == "" {
+		subscription.RetryPolicy = hammer.RetryPolicyFixed
+	}
+	if subscription.OrderingFailurePolicy == "" {
+		subscription.OrderingFailurePolicy = hammer.OrderingFailurePolicySkip
+	}
+	if subscription.SuccessStatusCodes == "" {
+		subscription.SuccessStatusCodes = hammer.DefaultSuccessStatusCodes
+	}
+	if subscription.RedirectPolicy == "" {
+		subscription.RedirectPolicy = hammer.RedirectPolicyFollow
+	}
+	if subscription.HTTPMethod == "" {
+		subscription.HTTPMethod = http.MethodPost
+	}
+	if subscription.PayloadFormat == "" {
+		subscription.PayloadFormat = hammer.PayloadFormatStructured
+	}
 	err = s.subscriptionRepo.Store(tx, subscription)
 	if err != nil {
 		return err
@@ -90,15 +181,57 @@ func (s *Subscription) Update(subscription *hammer.Subscription) error {
 		return err
 	}
 
-	// Update subscription
-	tx, err := s.txFactoryRepo.New()
+	// Verify if dead-letter topic already exists
+	err = s.deadLetterTopicExists(subscription.DeadLetterTopicID)
 	if err != nil {
 		return err
 	}
+
+	// Merge with the stored subscription
 	subscription.ID = subscriptionFromRepo.ID
 	subscription.TopicID = subscriptionFromRepo.TopicID
 	subscription.CreatedAt = subscriptionFromRepo.CreatedAt
 	subscription.UpdatedAt = time.Now().UTC()
+	if subscription.SigningMode == "" {
+		subscription.SigningMode = subscriptionFromRepo.SigningMode
+	}
+	if subscription.RetryPolicy == "" {
+		subscription.RetryPolicy = subscriptionFromRepo.RetryPolicy
+	}
+	if subscription.OrderingFailurePolicy == "" {
+		subscription.OrderingFailurePolicy = subscriptionFromRepo.OrderingFailurePolicy
+	}
+	if subscription.SuccessStatusCodes == "" {
+		subscription.SuccessStatusCodes = subscriptionFromRepo.SuccessStatusCodes
+	}
+	if subscription.RedirectPolicy == "" {
+		subscription.RedirectPolicy = subscriptionFromRepo.RedirectPolicy
+	}
+	if subscription.HTTPMethod == "" {
+		subscription.HTTPMethod = subscriptionFromRepo.HTTPMethod
+	}
+	if subscription.PayloadFormat == "" {
+		subscription.PayloadFormat = subscriptionFromRepo.PayloadFormat
+	}
+	// The paused column is changed only by Pause and Resume
+	subscription.Paused = subscriptionFromRepo.Paused
+	subscription.Headers = mergeHeaders(subscription.Headers, subscriptionFromRepo.Headers)
+
+	// Validate the merged subscription, the stored values can conflict with the informed ones
+	err = subscription.Validate()
+	if err != nil {
+		return err
+	}
+	err = s.deadLetterTopicCycle(subscription)
+	if err != nil {
+		return err
+	}
+
+	// Update subscription
+	tx, err := s.txFactoryRepo.New()
+	if err != nil {
+		return err
+	}
 	err = s.subscriptionRepo.Store(tx, subscription)
 	if err != nil {
 		return err
@@ -112,6 +245,50 @@ func (s *Subscription) Update(subscription *hammer.Subscription) error {
 	return nil
 }
 
+func (s *Subscription) setPaused(id string, paused bool) (hammer.Subscription, error) {
+	// Update only the paused column, a concurrent update of the subscription is kept
+	tx, err := s.txFactoryRepo.New()
+	if err != nil {
+		return hammer.Subscription{}, err
+	}
+	if paused {
+		err = s.subscriptionRepo.Pause(tx, id)
+	} else {
+		err = s.subscriptionRepo.Resume(tx, id)
+	}
+	if err != nil {
+		rollback(tx, "subscription-set-paused-rollback")
+		return hammer.Subscription{}, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "subscription-set-paused-rollback")
+		return hammer.Subscription{}, err
+	}
+
+	// Load the updated subscription
+	subscription, err := s.subscriptionRepo.Find(id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return subscription, hammer.ErrSubscriptionDoesNotExists
+		}
+		return subscription, err
+	}
+
+	return subscription, nil
+}
+
+// Pause stops the subscription deliveries from being dispatched, new deliveries are still created
+func (s *Subscription) Pause(id string) (hammer.Subscription, error) {
+	return s.setPaused(id, true)
+}
+
+// Resume allows the subscription deliveries to be dispatched again, the workers are notified
+// to dispatch the pending deliveries immediately
+func (s *Subscription) Resume(id string) (hammer.Subscription, error) {
+	return s.setPaused(id, false)
+}
+
 // Delete a hammer.Subscription on repository
 func (s *Subscription) Delete(id string) error {
 	tx, err := s.txFactoryRepo.New()
@@ -141,3 +318,30 @@ func NewSubscription(topicRepo hammer.TopicRepository, subscriptionRepo hammer.S
 		txFactoryRepo:    txFactoryRepo,
 	}
 }
+
+// PreviewTransform returns the message with the data and content type rendered by the subscription transform template
+func (s *Subscription) PreviewTransform(subscription *hammer.Subscription, message *hammer.Message) (hammer.Message, error) {
+	delivery := hammer.Delivery{
+		TopicID:              message.TopicID,
+		SubscriptionID:       subscription.ID,
+		MessageID:            message.ID,
+		ContentType:          message.ContentType,
+		Data:                 b64.StdEncoding.EncodeToString([]byte(message.Data)),
+		Attributes:           message.Attributes,
+		TransformTemplate:    subscription.TransformTemplate,
+		TransformContentType: subscription.TransformContentType,
+	}
+	transformed, err := transformDelivery(&delivery)
+	if err != nil {
+		return hammer.Message{}, err
+	}
+	data, err := b64.StdEncoding.DecodeString(transformed.Data)
+	if err != nil {
+		return hammer.Message{}, err
+	}
+
+	preview := *message
+	preview.ContentType = transformed.ContentType
+	preview.Data = string(data)
+	return preview, nil
+}
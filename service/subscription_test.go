@@ -2,10 +2,13 @@ package service
 
 import (
 	"database/sql"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/allisson/hammer"
 	"github.com/allisson/hammer/mocks"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -63,6 +66,31 @@ func TestSubscription(t *testing.T) {
 		assert.NotEqual(t, "", subscription.SecretToken)
 	})
 
+	t.Run("Test Create without signing mode", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.SigningMode = ""
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(hammer.Subscription{}, sql.ErrNoRows)
+		txRepo.On("Commit").Return(nil)
+
+		err := subscriptionService.Create(&subscription)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.SigningModeHMAC, subscription.SigningMode)
+		assert.Equal(t, hammer.OrderingFailurePolicySkip, subscription.OrderingFailurePolicy)
+		assert.Equal(t, hammer.DefaultSuccessStatusCodes, subscription.SuccessStatusCodes)
+		assert.Equal(t, hammer.RedirectPolicyFollow, subscription.RedirectPolicy)
+		assert.Equal(t, http.MethodPost, subscription.HTTPMethod)
+	})
+
 	t.Run("Test Create without secret token", func(t *testing.T) {
 		topic := hammer.MakeTestTopic()
 		subscription := hammer.MakeTestSubscription()
@@ -84,6 +112,30 @@ func TestSubscription(t *testing.T) {
 		assert.Equal(t, hammer.DefaultSecretTokenLength, len(subscription.SecretToken))
 	})
 
+	t.Run("Test Create with dead-letter topic cycle", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		deadLetterTopic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.DeadLetterTopicID = deadLetterTopic.ID
+		deadLetterSubscription := hammer.MakeTestSubscription()
+		deadLetterSubscription.TopicID = deadLetterTopic.ID
+		deadLetterSubscription.DeadLetterTopicID = topic.ID
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(hammer.Subscription{}, sql.ErrNoRows)
+		subscriptionRepo.On("FindAll", mock.MatchedBy(func(findOptions hammer.FindOptions) bool {
+			return findOptions.FindFilters[0].Value == deadLetterTopic.ID
+		})).Return([]hammer.Subscription{deadLetterSubscription}, nil)
+
+		err := subscriptionService.Create(&subscription)
+		assert.Equal(t, hammer.ErrDeadLetterTopicCycle, err)
+		subscriptionRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Test Create with topic does not exists on repository", func(t *testing.T) {
 		topic := hammer.MakeTestTopic()
 		subscription := hammer.MakeTestSubscription()
@@ -126,7 +178,7 @@ func TestSubscription(t *testing.T) {
 		topicRepo.On("Find", mock.Anything).Return(topic, nil)
 		txFactoryRepo.On("New").Return(txRepo, nil)
 		subscriptionRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
-		subscriptionRepo.On("Find", mock.Anything).Return(hammer.Subscription{}, nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(subscription, nil)
 		txRepo.On("Commit").Return(nil)
 
 		subscription.Name = "My Subscription"
@@ -134,6 +186,122 @@ func TestSubscription(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test Update with raw payload format and stored legacy signing mode", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		subscriptionFromRepo := hammer.MakeTestSubscription()
+		subscriptionFromRepo.TopicID = topic.ID
+		subscriptionFromRepo.SigningMode = hammer.SigningModeLegacy
+		subscription := subscriptionFromRepo
+		subscription.SigningMode = ""
+		subscription.PayloadFormat = hammer.PayloadFormatRaw
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(subscriptionFromRepo, nil)
+
+		err := subscriptionService.Update(&subscription)
+		errs, ok := err.(validation.Errors)
+		assert.True(t, ok)
+		assert.Contains(t, errs, "signing_mode")
+		subscriptionRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Update with redacted headers", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		subscriptionFromRepo := hammer.MakeTestSubscription()
+		subscriptionFromRepo.TopicID = topic.ID
+		subscriptionFromRepo.Headers = hammer.StringMap{"Authorization": "Bearer secret", "X-Tenant-Id": "tenant"}
+		subscription := subscriptionFromRepo
+		subscription.Headers = hammer.StringMap{"Authorization": hammer.RedactedHeaderValue, "X-Tenant-Id": "other"}
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(subscriptionFromRepo, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := subscriptionService.Update(&subscription)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.StringMap{"Authorization": "Bearer secret", "X-Tenant-Id": "other"}, subscription.Headers)
+	})
+
+	t.Run("Test Update without headers", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		subscriptionFromRepo := hammer.MakeTestSubscription()
+		subscriptionFromRepo.TopicID = topic.ID
+		subscriptionFromRepo.Headers = hammer.StringMap{"Authorization": "Bearer secret"}
+		subscription := subscriptionFromRepo
+		subscription.Headers = nil
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(subscriptionFromRepo, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := subscriptionService.Update(&subscription)
+		assert.Nil(t, err)
+		assert.Equal(t, hammer.StringMap{"Authorization": "Bearer secret"}, subscription.Headers)
+	})
+
+	t.Run("Test Update with dead-letter topic does not exists on repository", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscription.DeadLetterTopicID = "dead-letter"
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", topic.ID).Return(topic, nil)
+		topicRepo.On("Find", "dead-letter").Return(hammer.Topic{}, sql.ErrNoRows)
+		subscriptionRepo.On("Find", mock.Anything).Return(hammer.Subscription{}, nil)
+
+		err := subscriptionService.Update(&subscription)
+		assert.Equal(t, hammer.ErrDeadLetterTopicDoesNotExists, err)
+	})
+
+	t.Run("Test Update with dead-letter topic cycle", func(t *testing.T) {
+		topicA := hammer.MakeTestTopic()
+		topicB := hammer.MakeTestTopic()
+		topicC := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topicA.ID
+		subscription.DeadLetterTopicID = topicB.ID
+		subscriptionB := hammer.MakeTestSubscription()
+		subscriptionB.TopicID = topicB.ID
+		subscriptionB.DeadLetterTopicID = topicC.ID
+		subscriptionC := hammer.MakeTestSubscription()
+		subscriptionC.TopicID = topicC.ID
+		subscriptionC.DeadLetterTopicID = topicA.ID
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topicA, nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(subscription, nil)
+		subscriptionRepo.On("FindAll", mock.MatchedBy(func(findOptions hammer.FindOptions) bool {
+			return findOptions.FindFilters[0].Value == topicB.ID
+		})).Return([]hammer.Subscription{subscriptionB}, nil)
+		subscriptionRepo.On("FindAll", mock.MatchedBy(func(findOptions hammer.FindOptions) bool {
+			return findOptions.FindFilters[0].Value == topicC.ID
+		})).Return([]hammer.Subscription{subscriptionC}, nil)
+
+		err := subscriptionService.Update(&subscription)
+		assert.Equal(t, hammer.ErrDeadLetterTopicCycle, err)
+		subscriptionRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Test Update with topic does not exists on repository", func(t *testing.T) {
 		topic := hammer.MakeTestTopic()
 		subscription := hammer.MakeTestSubscription()
@@ -183,4 +351,109 @@ func TestSubscription(t *testing.T) {
 		err := subscriptionService.Delete(subscription.ID)
 		assert.Nil(t, err)
 	})
+
+	t.Run("Test Update keeps paused", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		subscription := hammer.MakeTestSubscription()
+		subscription.TopicID = topic.ID
+		subscriptionFromRepo := subscription
+		subscriptionFromRepo.Paused = true
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		topicRepo.On("Find", mock.Anything).Return(topic, nil)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Store", mock.Anything, mock.Anything).Return(nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(subscriptionFromRepo, nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := subscriptionService.Update(&subscription)
+		assert.Nil(t, err)
+		assert.True(t, subscription.Paused)
+	})
+
+	t.Run("Test Pause", func(t *testing.T) {
+		subscription := hammer.MakeTestSubscription()
+		subscription.Paused = true
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Pause", txRepo, subscription.ID).Return(nil)
+		txRepo.On("Commit").Return(nil)
+		subscriptionRepo.On("Find", subscription.ID).Return(subscription, nil)
+
+		subscription, err := subscriptionService.Pause(subscription.ID)
+		assert.Nil(t, err)
+		assert.True(t, subscription.Paused)
+		subscriptionRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Resume", func(t *testing.T) {
+		subscription := hammer.MakeTestSubscription()
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Resume", txRepo, subscription.ID).Return(nil)
+		txRepo.On("Commit").Return(nil)
+		subscriptionRepo.On("Find", subscription.ID).Return(subscription, nil)
+
+		subscription, err := subscriptionService.Resume(subscription.ID)
+		assert.Nil(t, err)
+		assert.False(t, subscription.Paused)
+		subscriptionRepo.AssertCalled(t, "Resume", txRepo, subscription.ID)
+		subscriptionRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Pause with subscription does not exists on repository", func(t *testing.T) {
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		subscriptionRepo.On("Pause", txRepo, "subscription_id").Return(nil)
+		txRepo.On("Commit").Return(nil)
+		subscriptionRepo.On("Find", mock.Anything).Return(hammer.Subscription{}, sql.ErrNoRows)
+
+		_, err := subscriptionService.Pause("subscription_id")
+		assert.Equal(t, hammer.ErrSubscriptionDoesNotExists, err)
+	})
+
+	t.Run("Test PreviewTransform", func(t *testing.T) {
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		subscription := hammer.MakeTestSubscription()
+		subscription.TransformTemplate = `{"text": {{json (printf "%s on %s" .data.name .subscription_id)}}}`
+		subscription.TransformContentType = "application/vnd.chat+json"
+		message := hammer.MakeTestMessage()
+
+		preview, err := subscriptionService.PreviewTransform(&subscription, &message)
+		assert.Nil(t, err)
+		assert.Equal(t, "application/vnd.chat+json", preview.ContentType)
+		assert.Equal(t, fmt.Sprintf(`{"text": "Allisson on %s"}`, subscription.ID), preview.Data)
+		assert.Equal(t, message.ID, preview.ID)
+	})
+
+	t.Run("Test PreviewTransform with execution error", func(t *testing.T) {
+		topicRepo := &mocks.TopicRepository{}
+		subscriptionRepo := &mocks.SubscriptionRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		subscriptionService := NewSubscription(topicRepo, subscriptionRepo, txFactoryRepo)
+		subscription := hammer.MakeTestSubscription()
+		subscription.TransformTemplate = `{{index .data.name 10}}`
+		message := hammer.MakeTestMessage()
+
+		_, err := subscriptionService.PreviewTransform(&subscription, &message)
+		assert.NotNil(t, err)
+	})
 }
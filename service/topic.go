@@ -79,6 +79,9 @@ func (t *Topic) Update(topic *hammer.Topic) error {
 		return err
 	}
 	topic.ID = topicFromRepo.ID
+	// The schema is not stored by the topic update, it is changed only by TopicSchema.Update
+	topic.Schema = topicFromRepo.Schema
+	topic.SchemaVersion = topicFromRepo.SchemaVersion
 	topic.CreatedAt = topicFromRepo.CreatedAt
 	topic.UpdatedAt = time.Now().UTC()
 	err = t.topicRepo.Store(tx, topic)
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/allisson/hammer"
+)
+
+// TopicSchema is a implementation of hammer.TopicSchemaService
+type TopicSchema struct {
+	topicRepo       hammer.TopicRepository
+	topicSchemaRepo hammer.TopicSchemaRepository
+	txFactoryRepo   hammer.TxFactoryRepository
+}
+
+// Find returns hammer.TopicSchema by topic id and version, the version 0 returns the current topic schema
+func (t *TopicSchema) Find(topicID string, version int) (hammer.TopicSchema, error) {
+	if version == 0 {
+		topic, err := t.topicRepo.Find(topicID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return hammer.TopicSchema{}, hammer.ErrTopicDoesNotExists
+			}
+			return hammer.TopicSchema{}, err
+		}
+		if topic.SchemaVersion == 0 {
+			return hammer.TopicSchema{}, hammer.ErrTopicSchemaDoesNotExists
+		}
+		version = topic.SchemaVersion
+	}
+
+	topicSchema, err := t.topicSchemaRepo.Find(topicID, version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return hammer.TopicSchema{}, hammer.ErrTopicSchemaDoesNotExists
+		}
+		return hammer.TopicSchema{}, err
+	}
+
+	return topicSchema, nil
+}
+
+// Update stores a new version of the topic schema and sets it as the current topic schema
+func (t *TopicSchema) Update(topicSchema *hammer.TopicSchema) error {
+	tx, err := t.txFactoryRepo.New()
+	if err != nil {
+		return err
+	}
+	topicSchema.Schema = strings.TrimSpace(topicSchema.Schema)
+	topicSchema.CreatedAt = time.Now().UTC()
+	err = t.topicSchemaRepo.Store(tx, topicSchema)
+	if err != nil {
+		rollback(tx, "topic-schema-update-rollback")
+		if err == sql.ErrNoRows {
+			return hammer.ErrTopicDoesNotExists
+		}
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		rollback(tx, "topic-schema-update-rollback")
+		return err
+	}
+
+	return nil
+}
+
+// NewTopicSchema returns a new TopicSchema with topicRepo, topicSchemaRepo and txFactoryRepo
+func NewTopicSchema(topicRepo hammer.TopicRepository, topicSchemaRepo hammer.TopicSchemaRepository, txFactoryRepo hammer.TxFactoryRepository) TopicSchema {
+	return TopicSchema{
+		topicRepo:       topicRepo,
+		topicSchemaRepo: topicSchemaRepo,
+		txFactoryRepo:   txFactoryRepo,
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/allisson/hammer"
+	"github.com/allisson/hammer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTopicSchema(t *testing.T) {
+	t.Run("Test Find", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		expectedTopicSchema := hammer.TopicSchema{TopicID: topic.ID, Version: 1, Schema: `{"type": "object"}`}
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		topicSchemaRepo.On("Find", topic.ID, 1).Return(expectedTopicSchema, nil)
+
+		topicSchema, err := topicSchemaService.Find(topic.ID, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTopicSchema, topicSchema)
+	})
+
+	t.Run("Test Find current version", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		topic.Schema = `{"type": "object"}`
+		topic.SchemaVersion = 2
+		expectedTopicSchema := hammer.TopicSchema{TopicID: topic.ID, Version: 2, Schema: topic.Schema}
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		topicRepo.On("Find", topic.ID).Return(topic, nil)
+		topicSchemaRepo.On("Find", topic.ID, 2).Return(expectedTopicSchema, nil)
+
+		topicSchema, err := topicSchemaService.Find(topic.ID, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTopicSchema, topicSchema)
+	})
+
+	t.Run("Test Find with topic without schema", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		topicRepo.On("Find", topic.ID).Return(topic, nil)
+
+		_, err := topicSchemaService.Find(topic.ID, 0)
+		assert.Equal(t, hammer.ErrTopicSchemaDoesNotExists, err)
+	})
+
+	t.Run("Test Find with version does not exists on repository", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		topicSchemaRepo.On("Find", topic.ID, 3).Return(hammer.TopicSchema{}, sql.ErrNoRows)
+
+		_, err := topicSchemaService.Find(topic.ID, 3)
+		assert.Equal(t, hammer.ErrTopicSchemaDoesNotExists, err)
+	})
+
+	t.Run("Test Update", func(t *testing.T) {
+		topic := hammer.MakeTestTopic()
+		topicSchema := hammer.TopicSchema{TopicID: topic.ID, Schema: `{"type": "object"}`}
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		topicSchemaRepo.On("Store", txRepo, &topicSchema).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(*hammer.TopicSchema).Version = 2
+		})
+		txRepo.On("Commit").Return(nil)
+
+		err := topicSchemaService.Update(&topicSchema)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, topicSchema.Version)
+		assert.False(t, topicSchema.CreatedAt.IsZero())
+		topicRepo.AssertNotCalled(t, "Store", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test Update with blank schema", func(t *testing.T) {
+		topicSchema := hammer.TopicSchema{TopicID: "topic", Schema: "  \n"}
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		topicSchemaRepo.On("Store", txRepo, &topicSchema).Return(nil)
+		txRepo.On("Commit").Return(nil)
+
+		err := topicSchemaService.Update(&topicSchema)
+		assert.Nil(t, err)
+		assert.Equal(t, "", topicSchema.Schema)
+	})
+
+	t.Run("Test Update with topic does not exists on repository", func(t *testing.T) {
+		topicSchema := hammer.TopicSchema{TopicID: "topic", Schema: `{"type": "object"}`}
+		topicRepo := &mocks.TopicRepository{}
+		topicSchemaRepo := &mocks.TopicSchemaRepository{}
+		txFactoryRepo := &mocks.TxFactoryRepository{}
+		txRepo := &mocks.TxRepository{}
+		topicSchemaService := NewTopicSchema(topicRepo, topicSchemaRepo, txFactoryRepo)
+		txFactoryRepo.On("New").Return(txRepo, nil)
+		topicSchemaRepo.On("Store", txRepo, &topicSchema).Return(sql.ErrNoRows)
+		txRepo.On("Rollback").Return(nil)
+
+		err := topicSchemaService.Update(&topicSchema)
+		assert.Equal(t, hammer.ErrTopicDoesNotExists, err)
+	})
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"bytes"
+	b64 "encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/allisson/hammer"
+	"github.com/allisson/hammer/transform"
+)
+
+// transformDocument returns the document used to render the subscription transform templates
+func transformDocument(delivery *hammer.Delivery, data []byte) map[string]interface{} {
+	document := map[string]interface{}{
+		"topic_id":        delivery.TopicID,
+		"subscription_id": delivery.SubscriptionID,
+		"message_id":      delivery.MessageID,
+		"delivery_id":     delivery.ID,
+		"content_type":    delivery.ContentType,
+		"attributes":      map[string]string(delivery.Attributes),
+		"data":            string(data),
+	}
+	if isJSONContentType(delivery.ContentType) && json.Valid(data) {
+		// Numbers are kept as json.Number, float64 would round the integers above 2^53
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err == nil {
+			document["data"] = value
+		}
+	}
+	return document
+}
+
+// transformDelivery returns the delivery with the data and content type replaced by the transform template output
+func transformDelivery(delivery *hammer.Delivery) (*hammer.Delivery, error) {
+	if strings.TrimSpace(delivery.TransformTemplate) == "" {
+		return delivery, nil
+	}
+	tmpl, err := transform.Parse(delivery.TransformTemplate)
+	if err != nil {
+		return nil, err
+	}
+	data, err := b64.StdEncoding.DecodeString(delivery.Data)
+	if err != nil {
+		return nil, err
+	}
+	output, err := tmpl.Execute(transformDocument(delivery, data))
+	if err != nil {
+		return nil, err
+	}
+
+	transformed := *delivery
+	transformed.Data = b64.StdEncoding.EncodeToString(output)
+	if delivery.TransformContentType != "" {
+		transformed.ContentType = delivery.TransformContentType
+	}
+	return &transformed, nil
+}
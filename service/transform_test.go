@@ -0,0 +1,79 @@
+package service
+
+import (
+	b64 "encoding/base64"
+	"testing"
+
+	"github.com/allisson/hammer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformDelivery(t *testing.T) {
+	t.Run("Test without template", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+
+		transformed, err := transformDelivery(&delivery)
+		assert.Nil(t, err)
+		assert.Equal(t, &delivery, transformed)
+	})
+
+	t.Run("Test with blank template", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.TransformTemplate = "  \n"
+
+		transformed, err := transformDelivery(&delivery)
+		assert.Nil(t, err)
+		assert.Equal(t, &delivery, transformed)
+	})
+
+	t.Run("Test with template", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.ContentType = "text/plain"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte("Order created"))
+		delivery.Attributes = hammer.StringMap{"region": "us"}
+		delivery.TransformTemplate = `{"text": {{json .data}}, "region": {{json .attributes.region}}, "id": {{json .delivery_id}}}`
+		delivery.TransformContentType = "application/json"
+
+		transformed, err := transformDelivery(&delivery)
+		assert.Nil(t, err)
+		data, err := b64.StdEncoding.DecodeString(transformed.Data)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"text": "Order created", "region": "us", "id": "`+delivery.ID+`"}`, string(data))
+		assert.Equal(t, "application/json", transformed.ContentType)
+		assert.Equal(t, "text/plain", delivery.ContentType)
+	})
+
+	t.Run("Test with json data", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.ContentType = "application/json"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte(`{"id": 9007199254740993, "name": "Allisson"}`))
+		delivery.TransformTemplate = `{"id": {{json .data.id}}, "name": {{json .data.name}}}`
+
+		transformed, err := transformDelivery(&delivery)
+		assert.Nil(t, err)
+		data, err := b64.StdEncoding.DecodeString(transformed.Data)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"id": 9007199254740993, "name": "Allisson"}`, string(data))
+	})
+
+	t.Run("Test with json data and non json content type", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.ContentType = "text/plain"
+		delivery.Data = b64.StdEncoding.EncodeToString([]byte(`{"id": 1}`))
+		delivery.TransformTemplate = `{{json .data}}`
+
+		transformed, err := transformDelivery(&delivery)
+		assert.Nil(t, err)
+		data, err := b64.StdEncoding.DecodeString(transformed.Data)
+		assert.Nil(t, err)
+		assert.Equal(t, `"{\"id\": 1}"`, string(data))
+	})
+
+	t.Run("Test with invalid template", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.TransformTemplate = `{{.data`
+
+		_, err := transformDelivery(&delivery)
+		assert.NotNil(t, err)
+	})
+}
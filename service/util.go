@@ -2,6 +2,7 @@ package service
 
 import (
 	mathrand "math/rand"
+	"sync"
 	"time"
 
 	"github.com/allisson/hammer"
@@ -11,6 +12,11 @@ import (
 
 const charset = "abcdefghijklmnopqrstuvwxyz" + "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
+var (
+	ulidEntropy   = ulid.Monotonic(mathrand.New(mathrand.NewSource(time.Now().UnixNano())), 0)
+	ulidEntropyMu sync.Mutex
+)
+
 func generateRandomString(length int) string {
 	seededRand := mathrand.New(mathrand.NewSource(time.Now().UnixNano()))
 	b := make([]byte, length)
@@ -20,11 +26,12 @@ func generateRandomString(length int) string {
 	return string(b)
 }
 
+// generateULID returns a new ULID, the ids generated by this process on the same millisecond
+// are monotonic so the id order is the publish order used by the ordered deliveries
 func generateULID() (string, error) {
-	seed := time.Now().UnixNano()
-	source := mathrand.NewSource(seed)
-	entropy := mathrand.New(source)
-	id, err := ulid.New(ulid.Timestamp(time.Now()), entropy)
+	ulidEntropyMu.Lock()
+	defer ulidEntropyMu.Unlock()
+	id, err := ulid.New(ulid.Timestamp(time.Now()), ulidEntropy)
 	return id.String(), err
 }
 
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateULID(t *testing.T) {
+	t.Run("Test monotonic ids", func(t *testing.T) {
+		previous, err := generateULID()
+		assert.Nil(t, err)
+		for i := 0; i < 1000; i++ {
+			id, err := generateULID()
+			assert.Nil(t, err)
+			assert.True(t, id > previous)
+			previous = id
+		}
+	})
+}
@@ -1,74 +1,45 @@
 package service
 
 import (
-	"hash/fnv"
-	"math/rand"
+	"context"
+	"fmt"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
-	"github.com/allisson/go-pglock"
 	"github.com/allisson/hammer"
 	"go.uber.org/zap"
 )
 
 // Worker is a implementation of hammer.WorkerService
 type Worker struct {
-	lock            pglock.Locker
+	owner           string
 	deliveryService hammer.DeliveryService
+	notifications   <-chan struct{}
+	slots           chan struct{}
 	wg              sync.WaitGroup
-	run             bool
+	stopping        chan struct{}
+	stopOnce        sync.Once
+	interrupt       chan struct{}
+	interruptOnce   sync.Once
+	finished        chan struct{}
 }
 
-func (w *Worker) stringToInt(s string) int64 {
-	h := fnv.New32a()
-	_, err := h.Write([]byte(s))
-	if err != nil {
-		return 0
-	}
-	return int64(h.Sum32())
-}
-
-func (w *Worker) unlock(lockID int64) {
-	err := w.lock.Unlock(lockID)
-	if err != nil {
-		logger.Error("unlock-delivery", zap.Error(err))
-	}
-}
-
-func (w *Worker) dispatch(deliveryID string) {
+func (w *Worker) dispatch(ctx context.Context, delivery hammer.Delivery) {
 	defer w.wg.Done()
-
-	// Get lock
-	lockID := w.stringToInt(deliveryID)
-	ok, err := w.lock.Lock(lockID)
-	if err != nil {
-		logger.Error("lock-delivery", zap.Error(err))
-		return
-	}
-	if !ok {
-		return
-	}
-	defer w.unlock(lockID)
-
-	// Get delivery
-	delivery, err := w.deliveryService.Find(deliveryID)
-	if err != nil {
-		logger.Error("delivery-service-find", zap.Error(err))
-		return
-	}
-
-	// Check delivery
-	if delivery.Status != hammer.DeliveryStatusPending || time.Now().UTC().Before(delivery.ScheduledAt) {
-		return
-	}
+	defer w.release()
 
 	// Create http client with timeout
 	httpClient := &http.Client{Timeout: time.Duration(delivery.DeliveryAttemptTimeout) * time.Second}
 
 	// Dispatch
-	deliveryAttempt, err := w.deliveryService.Dispatch(&delivery, httpClient)
+	deliveryAttempt, err := w.deliveryService.Dispatch(ctx, &delivery, httpClient)
 	if err != nil {
+		if err == hammer.ErrDeliveryDispatchInterrupted {
+			logger.Info("delivery-interrupted", zap.String("delivery_id", delivery.ID))
+			return
+		}
 		logger.Error("delivery-service-dispatch", zap.Error(err))
 		return
 	}
@@ -95,52 +66,160 @@ func (w *Worker) dispatch(deliveryID string) {
 	}
 }
 
-// Run worker flow
-func (w *Worker) Run() error {
-	for w.run {
-		deliveries, err := w.deliveryService.FindToDispatch(hammer.WorkerDefaultFetchLimit, 0)
+// acquire waits for a free slot on the pool
+func (w *Worker) acquire() {
+	w.slots <- struct{}{}
+}
+
+// release frees a slot on the pool
+func (w *Worker) release() {
+	<-w.slots
+}
+
+// freeSlots returns the number of free slots on the pool
+func (w *Worker) freeSlots() int {
+	return cap(w.slots) - len(w.slots)
+}
+
+// wait returns false when the worker is stopping or ctx is done before the channel operation,
+// a notification of new deliveries ends the wait earlier
+func (w *Worker) wait(ctx context.Context, ch <-chan time.Time) bool {
+	select {
+	case <-ch:
+		return true
+	case <-w.notifications:
+		return true
+	case <-w.stopping:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// stopped returns true when Stop was called or ctx is done
+func (w *Worker) stopped(ctx context.Context) bool {
+	select {
+	case <-w.stopping:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// Run worker flow, deliveries are claimed as soon as there are free slots on the pool
+// so a slow delivery does not block the others.
+// Run returns after Stop is called or ctx is done and the in-flight deliveries are finished,
+// the in-flight deliveries are interrupted when ctx is done or the Stop ctx expires.
+func (w *Worker) Run(ctx context.Context) error {
+	defer close(w.finished)
+
+	// Cancel the in-flight deliveries on interrupt
+	dispatchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-w.interrupt:
+			cancel()
+		case <-dispatchCtx.Done():
+		}
+	}()
+
+	var err error
+	for {
+		// Wait for a free slot, only Run acquires slots so the free slots can't decrease until the next claim
+		acquired := false
+		select {
+		case w.slots <- struct{}{}:
+			acquired = true
+		case <-w.stopping:
+		case <-ctx.Done():
+		}
+		if !acquired {
+			break
+		}
+		// select picks at random when a slot and stopping are both ready, don't claim after Stop
+		if w.stopped(ctx) {
+			w.release()
+			break
+		}
+		limit := w.freeSlots() + 1
+		if limit > hammer.WorkerDefaultFetchLimit {
+			limit = hammer.WorkerDefaultFetchLimit
+		}
+
+		var deliveries []hammer.Delivery
+		deliveries, err = w.deliveryService.Claim(w.owner, limit)
 		if err != nil {
-			return err
+			w.release()
+			break
 		}
 
 		if len(deliveries) == 0 {
-			time.Sleep(time.Duration(hammer.WorkerDatabaseDelay) * time.Second)
+			w.release()
+			if !w.wait(ctx, time.After(time.Duration(hammer.WorkerDatabaseDelay)*time.Second)) {
+				break
+			}
 			continue
 		}
 
 		// Increment wait group
 		w.wg.Add(len(deliveries))
 
-		// Shuffle deliveries
-		rand.Seed(time.Now().UnixNano())
-		rand.Shuffle(len(deliveries), func(i, j int) { deliveries[i], deliveries[j] = deliveries[j], deliveries[i] })
-
-		for _, deliveryID := range deliveries {
-			id := deliveryID
-			go w.dispatch(id)
+		for i, delivery := range deliveries {
+			if i > 0 {
+				w.acquire()
+			}
+			go w.dispatch(dispatchCtx, delivery)
 		}
+	}
 
-		// Wait for goroutines to finish
-		w.wg.Wait()
+	// Wait for goroutines to finish
+	w.wg.Wait()
 
-		// Sleep with delay of hammer.WorkerDatabaseDelay
-		time.Sleep(time.Duration(hammer.WorkerDatabaseDelay) * time.Second)
-	}
+	return err
+}
 
-	return nil
+// Stop worker flow, no more deliveries are claimed and Stop waits for the in-flight deliveries to finish.
+// When ctx is done before, the in-flight deliveries are interrupted and left pending for retry.
+// Stop must be called after Run.
+func (w *Worker) Stop(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.stopping) })
+	select {
+	case <-w.finished:
+		return nil
+	case <-ctx.Done():
+		w.interruptOnce.Do(func() { close(w.interrupt) })
+		<-w.finished
+		return ctx.Err()
+	}
 }
 
-// Stop worker flow
-func (w *Worker) Stop() error {
-	w.run = false
-	return nil
+// workerOwner returns an unique name for the worker used on delivery leases
+func workerOwner() string {
+	hostname, _ := os.Hostname()
+	id, _ := generateULID()
+	return fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), id)
 }
 
-// NewWorker returns a new Worker
-func NewWorker(lock pglock.Locker, deliveryService hammer.DeliveryService) Worker {
+// NewWorker returns a new Worker that dispatches up to concurrency deliveries at the same time,
+// when notificationRepo is nil the worker only polls the database for new deliveries
+func NewWorker(deliveryService hammer.DeliveryService, notificationRepo hammer.NotificationRepository, concurrency int) Worker {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var notifications <-chan struct{}
+	if notificationRepo != nil {
+		notifications = notificationRepo.Notifications()
+	}
 	return Worker{
-		lock:            lock,
+		owner:           workerOwner(),
 		deliveryService: deliveryService,
-		run:             true,
+		notifications:   notifications,
+		slots:           make(chan struct{}, concurrency),
+		stopping:        make(chan struct{}),
+		interrupt:       make(chan struct{}),
+		finished:        make(chan struct{}),
 	}
 }
@@ -1,43 +1,228 @@
 package service
 
 import (
+	"context"
 	"testing"
 	"time"
 
-	lockmock "github.com/allisson/go-pglock/mocks"
 	"github.com/allisson/hammer"
 	"github.com/allisson/hammer/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+func matchDelivery(id string) interface{} {
+	return mock.MatchedBy(func(delivery *hammer.Delivery) bool { return delivery.ID == id })
+}
+
 func TestWorker(t *testing.T) {
-	delivery := hammer.MakeTestDelivery()
-	delivery.TopicID = "topic"
-	delivery.SubscriptionID = "subscription"
-	delivery.MessageID = "message"
-	delivery.Status = hammer.DeliveryStatusCompleted
-	deliveryAttempt := hammer.MakeTestDeliveryAttempt()
-	deliveryAttempt.DeliveryID = delivery.ID
-	deliveryService := &mocks.DeliveryService{}
-	lock := &lockmock.Locker{}
-	workerService := NewWorker(lock, deliveryService)
-	deliveryService.On("FindToDispatch", hammer.WorkerDefaultFetchLimit, 0).Return([]string{delivery.ID}, nil)
-	lock.On("Lock", mock.Anything).Return(true, nil)
-	deliveryService.On("Find", delivery.ID).Return(delivery, nil)
-	deliveryService.On("Dispatch", &delivery, mock.Anything).Return(deliveryAttempt, nil)
-	lock.On("Unlock", mock.Anything).Return(nil)
-
-	// Execute Run method in goroutine
-	go func() {
-		err := workerService.Run()
+	t.Run("Test Run", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		delivery.TopicID = "topic"
+		delivery.SubscriptionID = "subscription"
+		delivery.MessageID = "message"
+		delivery.Status = hammer.DeliveryStatusCompleted
+		deliveryAttempt := hammer.MakeTestDeliveryAttempt()
+		deliveryAttempt.DeliveryID = delivery.ID
+		deliveryService := &mocks.DeliveryService{}
+		workerService := NewWorker(deliveryService, nil, 10)
+		deliveryService.On("Claim", workerService.owner, 10).Return([]hammer.Delivery{delivery}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil)
+		deliveryService.On("Dispatch", mock.Anything, &delivery, mock.Anything).Return(deliveryAttempt, nil)
+
+		// Execute Run method in goroutine
+		go func() {
+			err := workerService.Run(context.Background())
+			assert.Nil(t, err)
+		}()
+
+		// Wait to Run method execute
+		time.Sleep(10 * time.Millisecond)
+
+		// Stop worker
+		err := workerService.Stop(context.Background())
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test Run with bounded concurrency", func(t *testing.T) {
+		databaseDelay := hammer.WorkerDatabaseDelay
+		hammer.WorkerDatabaseDelay = 0
+		defer func() { hammer.WorkerDatabaseDelay = databaseDelay }()
+		delivery1 := hammer.MakeTestDelivery()
+		delivery2 := hammer.MakeTestDelivery()
+		delivery3 := hammer.MakeTestDelivery()
+		deliveryAttempt := hammer.MakeTestDeliveryAttempt()
+		releaseDispatch := make(chan struct{})
+		dispatched := make(chan string, 3)
+		deliveryService := &mocks.DeliveryService{}
+		workerService := NewWorker(deliveryService, nil, 2)
+		deliveryService.On("Claim", workerService.owner, 2).Return([]hammer.Delivery{delivery1, delivery2}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, 1).Return([]hammer.Delivery{delivery3}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil)
+		deliveryService.On("Dispatch", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			dispatched <- args.Get(1).(*hammer.Delivery).ID
+			<-releaseDispatch
+		}).Return(deliveryAttempt, nil)
+
+		done := make(chan error)
+		go func() {
+			done <- workerService.Run(context.Background())
+		}()
+
+		// The pool is full, no more deliveries are claimed
+		assert.ElementsMatch(t, []string{delivery1.ID, delivery2.ID}, []string{<-dispatched, <-dispatched})
+		time.Sleep(10 * time.Millisecond)
+		deliveryService.AssertNumberOfCalls(t, "Claim", 1)
+		assert.Equal(t, 0, workerService.freeSlots())
+
+		// A free slot claims a new delivery
+		releaseDispatch <- struct{}{}
+		assert.Equal(t, delivery3.ID, <-dispatched)
+
+		close(releaseDispatch)
+		err := workerService.Stop(context.Background())
 		assert.Nil(t, err)
-	}()
+		assert.Nil(t, <-done)
+		deliveryService.AssertNumberOfCalls(t, "Dispatch", 3)
+	})
 
-	// Wait to Run method execute
-	time.Sleep(10 * time.Millisecond)
+	t.Run("Test Run without head-of-line blocking", func(t *testing.T) {
+		databaseDelay := hammer.WorkerDatabaseDelay
+		hammer.WorkerDatabaseDelay = 0
+		defer func() { hammer.WorkerDatabaseDelay = databaseDelay }()
+		slowDelivery := hammer.MakeTestDelivery()
+		fastDelivery1 := hammer.MakeTestDelivery()
+		fastDelivery2 := hammer.MakeTestDelivery()
+		deliveryAttempt := hammer.MakeTestDeliveryAttempt()
+		releaseSlowDelivery := make(chan struct{})
+		fastDispatched := make(chan string, 2)
+		deliveryService := &mocks.DeliveryService{}
+		workerService := NewWorker(deliveryService, nil, 10)
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{slowDelivery, fastDelivery1}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{fastDelivery2}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil)
+		deliveryService.On("Dispatch", mock.Anything, matchDelivery(slowDelivery.ID), mock.Anything).Run(func(args mock.Arguments) {
+			<-releaseSlowDelivery
+		}).Return(deliveryAttempt, nil)
+		deliveryService.On("Dispatch", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fastDispatched <- args.Get(1).(*hammer.Delivery).ID
+		}).Return(deliveryAttempt, nil)
 
-	// Stop worker
-	err := workerService.Stop()
-	assert.Nil(t, err)
+		done := make(chan error)
+		go func() {
+			done <- workerService.Run(context.Background())
+		}()
+
+		// Deliveries claimed after the slow delivery are dispatched while it is still running
+		assert.ElementsMatch(t, []string{fastDelivery1.ID, fastDelivery2.ID}, []string{<-fastDispatched, <-fastDispatched})
+
+		close(releaseSlowDelivery)
+		err := workerService.Stop(context.Background())
+		assert.Nil(t, err)
+		assert.Nil(t, <-done)
+	})
+
+	t.Run("Test Stop drains in-flight deliveries", func(t *testing.T) {
+		databaseDelay := hammer.WorkerDatabaseDelay
+		hammer.WorkerDatabaseDelay = 0
+		defer func() { hammer.WorkerDatabaseDelay = databaseDelay }()
+		delivery := hammer.MakeTestDelivery()
+		deliveryAttempt := hammer.MakeTestDeliveryAttempt()
+		dispatched := make(chan struct{})
+		deliveryService := &mocks.DeliveryService{}
+		workerService := NewWorker(deliveryService, nil, 10)
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{delivery}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil)
+		deliveryService.On("Dispatch", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			close(dispatched)
+			time.Sleep(50 * time.Millisecond)
+		}).Return(deliveryAttempt, nil)
+
+		done := make(chan error)
+		go func() {
+			done <- workerService.Run(context.Background())
+		}()
+		<-dispatched
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		err := workerService.Stop(ctx)
+		assert.Nil(t, err)
+		assert.Nil(t, <-done)
+		deliveryService.AssertNumberOfCalls(t, "Dispatch", 1)
+	})
+
+	t.Run("Test Run does not claim after Stop", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			deliveryService := &mocks.DeliveryService{}
+			workerService := NewWorker(deliveryService, nil, 10)
+			close(workerService.stopping)
+
+			err := workerService.Run(context.Background())
+			assert.Nil(t, err)
+			deliveryService.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("Test Stop interrupts in-flight deliveries after timeout", func(t *testing.T) {
+		databaseDelay := hammer.WorkerDatabaseDelay
+		hammer.WorkerDatabaseDelay = 0
+		defer func() { hammer.WorkerDatabaseDelay = databaseDelay }()
+		delivery := hammer.MakeTestDelivery()
+		dispatched := make(chan struct{})
+		deliveryService := &mocks.DeliveryService{}
+		workerService := NewWorker(deliveryService, nil, 10)
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{delivery}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil)
+		deliveryService.On("Dispatch", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			close(dispatched)
+			<-args.Get(0).(context.Context).Done()
+		}).Return(hammer.DeliveryAttempt{}, hammer.ErrDeliveryDispatchInterrupted)
+
+		done := make(chan error)
+		go func() {
+			done <- workerService.Run(context.Background())
+		}()
+		<-dispatched
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := workerService.Stop(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Nil(t, <-done)
+	})
+	t.Run("Test Run wakes up on notification", func(t *testing.T) {
+		delivery := hammer.MakeTestDelivery()
+		deliveryAttempt := hammer.MakeTestDeliveryAttempt()
+		dispatched := make(chan struct{})
+		notifications := make(chan struct{}, 1)
+		deliveryService := &mocks.DeliveryService{}
+		notificationRepo := &mocks.NotificationRepository{}
+		notificationRepo.On("Notifications").Return((<-chan struct{})(notifications))
+		workerService := NewWorker(deliveryService, notificationRepo, 10)
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{delivery}, nil).Once()
+		deliveryService.On("Claim", workerService.owner, mock.Anything).Return([]hammer.Delivery{}, nil)
+		deliveryService.On("Dispatch", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			close(dispatched)
+		}).Return(deliveryAttempt, nil)
+
+		done := make(chan error)
+		go func() {
+			done <- workerService.Run(context.Background())
+		}()
+
+		// The worker is idle until the poll interval, a notification claims the delivery immediately
+		time.Sleep(10 * time.Millisecond)
+		notifications <- struct{}{}
+		select {
+		case <-dispatched:
+		case <-time.After(time.Second):
+			t.Fatal("delivery not dispatched after notification")
+		}
+
+		err := workerService.Stop(context.Background())
+		assert.Nil(t, err)
+		assert.Nil(t, <-done)
+	})
 }
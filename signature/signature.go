@@ -0,0 +1,100 @@
+// Package signature implements the HMAC-SHA256 signing scheme used by hammer
+// to sign webhook deliveries, receivers can use it to verify incoming requests.
+package signature
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TimestampHeader is the header that carries the unix timestamp used on signature
+	TimestampHeader = "X-Hammer-Timestamp"
+	// SignatureHeader is the header that carries the request signature
+	SignatureHeader = "X-Hammer-Signature"
+	// SignaturePrefix identifies the algorithm used on SignatureHeader value
+	SignaturePrefix = "sha256="
+	// DefaultTolerance is the default max age of a signed request
+	DefaultTolerance = 5 * time.Minute
+)
+
+var (
+	// ErrMissingTimestamp is used when the timestamp header is not present.
+	ErrMissingTimestamp = errors.New("missing_timestamp")
+	// ErrInvalidTimestamp is used when the timestamp header can't be parsed.
+	ErrInvalidTimestamp = errors.New("invalid_timestamp")
+	// ErrTimestampOutOfTolerance is used when the timestamp is too old or too far in the future.
+	ErrTimestampOutOfTolerance = errors.New("timestamp_out_of_tolerance")
+	// ErrMissingSignature is used when the signature header is not present.
+	ErrMissingSignature = errors.New("missing_signature")
+	// ErrInvalidSignature is used when the signature does not match.
+	ErrInvalidSignature = errors.New("invalid_signature")
+)
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" using secret as key
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SetHeaders signs the body and sets the timestamp and signature headers
+func SetHeaders(header http.Header, secret string, timestamp time.Time, body []byte) {
+	ts := timestamp.Unix()
+	header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	header.Set(SignatureHeader, SignaturePrefix+Sign(secret, ts, body))
+}
+
+// Verify checks the timestamp and signature header values against body, a tolerance of zero disables the timestamp check
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, tolerance time.Duration) error {
+	if timestampHeader == "" {
+		return ErrMissingTimestamp
+	}
+	ts, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if tolerance > 0 {
+		diff := time.Since(time.Unix(ts, 0))
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > tolerance {
+			return ErrTimestampOutOfTolerance
+		}
+	}
+
+	if signatureHeader == "" {
+		return ErrMissingSignature
+	}
+	if !strings.HasPrefix(signatureHeader, SignaturePrefix) {
+		return ErrInvalidSignature
+	}
+	expected := []byte(Sign(secret, ts, body))
+	if !hmac.Equal(expected, []byte(strings.TrimPrefix(signatureHeader, SignaturePrefix))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyRequest reads the request body and verifies its signature, the body is restored on request and returned
+func VerifyRequest(r *http.Request, secret string, tolerance time.Duration) ([]byte, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	err = Verify(secret, r.Header.Get(TimestampHeader), r.Header.Get(SignatureHeader), body, tolerance)
+	return body, err
+}
@@ -0,0 +1,68 @@
+package signature
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignature(t *testing.T) {
+	secret := "my-super-secret-token"
+	body := []byte(`{"id": "id"}`)
+
+	t.Run("Test Sign", func(t *testing.T) {
+		assert.Equal(t, Sign(secret, 1589738659, body), Sign(secret, 1589738659, body))
+		assert.NotEqual(t, Sign(secret, 1589738659, body), Sign(secret, 1589738660, body))
+		assert.NotEqual(t, Sign(secret, 1589738659, body), Sign("other-secret", 1589738659, body))
+		assert.Equal(t, 64, len(Sign(secret, 1589738659, body)))
+	})
+
+	t.Run("Test Verify", func(t *testing.T) {
+		header := http.Header{}
+		SetHeaders(header, secret, time.Now(), body)
+
+		err := Verify(secret, header.Get(TimestampHeader), header.Get(SignatureHeader), body, DefaultTolerance)
+		assert.Nil(t, err)
+
+		err = Verify("other-secret", header.Get(TimestampHeader), header.Get(SignatureHeader), body, DefaultTolerance)
+		assert.Equal(t, ErrInvalidSignature, err)
+
+		err = Verify(secret, header.Get(TimestampHeader), header.Get(SignatureHeader), []byte(`{}`), DefaultTolerance)
+		assert.Equal(t, ErrInvalidSignature, err)
+
+		err = Verify(secret, "", header.Get(SignatureHeader), body, DefaultTolerance)
+		assert.Equal(t, ErrMissingTimestamp, err)
+
+		err = Verify(secret, "now", header.Get(SignatureHeader), body, DefaultTolerance)
+		assert.Equal(t, ErrInvalidTimestamp, err)
+
+		err = Verify(secret, header.Get(TimestampHeader), "", body, DefaultTolerance)
+		assert.Equal(t, ErrMissingSignature, err)
+	})
+
+	t.Run("Test Verify with old timestamp", func(t *testing.T) {
+		timestamp := time.Now().Add(-time.Hour)
+		signature := SignaturePrefix + Sign(secret, timestamp.Unix(), body)
+		ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+		err := Verify(secret, ts, signature, body, DefaultTolerance)
+		assert.Equal(t, ErrTimestampOutOfTolerance, err)
+
+		err = Verify(secret, ts, signature, body, 0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Test VerifyRequest", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		SetHeaders(request.Header, secret, time.Now(), body)
+
+		requestBody, err := VerifyRequest(request, secret, DefaultTolerance)
+		assert.Nil(t, err)
+		assert.Equal(t, body, requestBody)
+	})
+}
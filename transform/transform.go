@@ -0,0 +1,93 @@
+// Package transform implements the templates used by subscriptions to reshape the
+// message data before the delivery.
+//
+// A template uses the text/template syntax and is rendered with a document that has
+// the message fields, for example:
+//
+//	{"text": {{json (printf "New order %v from %v" .data.id .data.customer.name)}}}
+//
+// The document has topic_id, subscription_id, message_id, delivery_id, content_type,
+// attributes and data. The data is the decoded json value when the message content is
+// json, otherwise it is the message content as string. Besides the text/template
+// builtins the functions json, lower, upper, trim and default are available.
+package transform
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// MaxOutputSize represents the max size in bytes of a rendered template
+const MaxOutputSize = 1 << 20
+
+var (
+	// ErrInvalidTemplate is used when the template can't be parsed.
+	ErrInvalidTemplate = errors.New("invalid_transform_template")
+	// ErrOutputTooLarge is used when the rendered template is larger than MaxOutputSize.
+	ErrOutputTooLarge = errors.New("transform_output_too_large")
+)
+
+var funcs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+	"default": func(defaultValue, value interface{}) interface{} {
+		if value == nil || value == "" {
+			return defaultValue
+		}
+		return value
+	},
+}
+
+// Template is a parsed transform template
+type Template struct {
+	tmpl *template.Template
+}
+
+// Execute renders the template with the document
+func (t *Template) Execute(document map[string]interface{}) ([]byte, error) {
+	w := &limitedBuffer{limit: MaxOutputSize}
+	if err := t.tmpl.Execute(w, document); err != nil {
+		return nil, err
+	}
+	return w.buf.Bytes(), nil
+}
+
+// Parse returns a new Template from s
+func Parse(s string) (*Template, error) {
+	tmpl, err := template.New("transform").Option("missingkey=zero").Funcs(funcs).Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return &Template{tmpl: tmpl}, nil
+}
+
+// Validate returns an error if s is not a valid template, an empty string is valid
+func Validate(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	_, err := Parse(s)
+	return err
+}
+
+// limitedBuffer is a bytes.Buffer that fails the writes after limit bytes
+type limitedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.buf.Len()+len(p) > b.limit {
+		return 0, ErrOutputTooLarge
+	}
+	return b.buf.Write(p)
+}
@@ -0,0 +1,63 @@
+package transform
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransform(t *testing.T) {
+	document := map[string]interface{}{
+		"message_id":   "message",
+		"content_type": "application/json",
+		"attributes":   map[string]string{"region": "us"},
+		"data": map[string]interface{}{
+			"id":       float64(1),
+			"status":   "PAID",
+			"customer": map[string]interface{}{"name": "Allisson \"A\""},
+		},
+	}
+
+	tables := []struct {
+		template string
+		output   string
+	}{
+		{`{"text": {{json (printf "Order %v from %v" .data.id .data.customer.name)}}}`, `{"text": "Order 1 from Allisson \"A\""}`},
+		{`{"id": {{json .data.id}}, "status": {{json (lower .data.status)}}}`, `{"id": 1, "status": "paid"}`},
+		{`{{.attributes.region | upper}} {{default "none" .data.coupon}}`, `US none`},
+		{`{{range $k, $v := .data.customer}}{{$k}}={{$v}}{{end}}`, `name=Allisson "A"`},
+		{`{{.message_id}}`, `message`},
+	}
+
+	for _, table := range tables {
+		tmpl, err := Parse(table.template)
+		assert.Nil(t, err, table.template)
+		output, err := tmpl.Execute(document)
+		assert.Nil(t, err, table.template)
+		assert.Equal(t, table.output, string(output), table.template)
+	}
+}
+
+func TestTransformErrors(t *testing.T) {
+	t.Run("Test invalid template", func(t *testing.T) {
+		for _, s := range []string{`{{.data`, `{{unknown .data}}`, `{{end}}`} {
+			_, err := Parse(s)
+			assert.True(t, errors.Is(err, ErrInvalidTemplate), s)
+			assert.True(t, errors.Is(Validate(s), ErrInvalidTemplate), s)
+		}
+		assert.Nil(t, Validate(""))
+	})
+
+	t.Run("Test output too large", func(t *testing.T) {
+		tmpl, err := Parse(`{{range .items}}{{.}}{{end}}`)
+		assert.Nil(t, err)
+		items := []string{}
+		for i := 0; i < 2; i++ {
+			items = append(items, strings.Repeat("a", MaxOutputSize/2+1))
+		}
+		_, err = tmpl.Execute(map[string]interface{}{"items": items})
+		assert.Equal(t, ErrOutputTooLarge, err)
+	})
+}